@@ -0,0 +1,180 @@
+package macaddr
+
+import (
+	"net"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+	"go.mdl.wtf/go-macaddr/internal/convert"
+	"go.mdl.wtf/go-macaddr/internal/format"
+)
+
+const (
+	// addrValid is set on every valid Addr, so the zero value is distinguishable from
+	// 00:00:00:00:00:00.
+	addrValid uint64 = 1 << 63
+	// addrBits masks the 48 bits of an Addr that contain the address.
+	addrBits uint64 = 1<<constant.MacBitLen - 1
+)
+
+// Addr represents a single 48-bit (EUI-48) MAC Address as an immutable value, modeled on
+// netip.Addr. Unlike MACAddress, Addr is comparable, so it may be used with == and as a map key,
+// and it never allocates. The zero value is not a valid address.
+type Addr struct {
+	v uint64
+}
+
+// AddrFrom6 returns the Addr of a 6 byte array.
+func AddrFrom6(b [6]byte) Addr {
+	return Addr{v: addrValid | convert.ByteArrayToUint64(b[:])}
+}
+
+// AddrFromSlice returns the Addr of a 6 byte slice, such as a net.HardwareAddr. If the slice is
+// not 6 bytes long, AddrFromSlice returns the zero Addr and false.
+func AddrFromSlice(b []byte) (Addr, bool) {
+	if len(b) != constant.MacByteLen {
+		return Addr{}, false
+	}
+	return Addr{v: addrValid | convert.ByteArrayToUint64(b)}, true
+}
+
+// AddrFromUint64 returns the Addr of the integer representation of an address. Only the lower 48
+// bits of v are used.
+func AddrFromUint64(v uint64) Addr {
+	return Addr{v: addrValid | v&addrBits}
+}
+
+// ParseAddr parses an input string to an Addr, accepting the same input as ParseMACAddress.
+func ParseAddr(s string) (Addr, error) {
+	mac, err := ParseMACAddress(s)
+	if err != nil {
+		return Addr{}, err
+	}
+	return mac.Addr(), nil
+}
+
+// MustParseAddr operates identically to ParseAddr, but panics on error instead of returning the
+// error. Most ideal for tests.
+func MustParseAddr(s string) Addr {
+	a, err := ParseAddr(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// Addr returns the MACAddress as an Addr. The zero Addr is returned if the MACAddress is nil or
+// not 6 bytes long.
+func (m *MACAddress) Addr() Addr {
+	if m == nil {
+		return Addr{}
+	}
+	a, _ := AddrFromSlice(*m)
+	return a
+}
+
+// IsValid determines if the Addr is a valid address, i.e. not the zero Addr.
+func (a Addr) IsValid() bool { return a.v&addrValid != 0 }
+
+// Uint64 returns the integer representation of the Addr, or 0 if the Addr is not valid.
+func (a Addr) Uint64() uint64 { return a.v & addrBits }
+
+// Compare returns an integer comparing two addresses: 0 if a == b, -1 if a < b and +1 if a > b.
+// The zero Addr sorts before all valid addresses.
+func (a Addr) Compare(b Addr) int {
+	switch {
+	case a.v < b.v:
+		return -1
+	case a.v > b.v:
+		return 1
+	}
+	return 0
+}
+
+// Less determines if a sorts before b.
+func (a Addr) Less(b Addr) bool { return a.Compare(b) < 0 }
+
+// As6 returns the Addr as a 6 byte array. The zero Addr returns all zeros.
+func (a Addr) As6() (b [6]byte) {
+	v := a.Uint64()
+	for i := constant.MacByteLen - 1; i >= 0; i-- {
+		b[i] = byte(v)
+		v >>= 8
+	}
+	return
+}
+
+// AsSlice returns the Addr as a 6 byte slice, or nil if the Addr is not valid.
+func (a Addr) AsSlice() []byte {
+	if !a.IsValid() {
+		return nil
+	}
+	b := a.As6()
+	return b[:]
+}
+
+// MACAddress returns the Addr as a MACAddress, or nil if the Addr is not valid.
+func (a Addr) MACAddress() *MACAddress {
+	if !a.IsValid() {
+		return nil
+	}
+	b := a.As6()
+	return FromByteArray(b[:])
+}
+
+// HardwareAddr returns the Addr as a net.HardwareAddr, or nil if the Addr is not valid.
+func (a Addr) HardwareAddr() net.HardwareAddr {
+	return net.HardwareAddr(a.AsSlice())
+}
+
+// Next returns the address following a. If a is ff:ff:ff:ff:ff:ff or not valid, Next returns the
+// zero Addr.
+func (a Addr) Next() Addr {
+	if !a.IsValid() || a.Uint64() == addrBits {
+		return Addr{}
+	}
+	return Addr{v: a.v + 1}
+}
+
+// Prev returns the address preceding a. If a is 00:00:00:00:00:00 or not valid, Prev returns the
+// zero Addr.
+func (a Addr) Prev() Addr {
+	if !a.IsValid() || a.Uint64() == 0 {
+		return Addr{}
+	}
+	return Addr{v: a.v - 1}
+}
+
+// String formats the Addr with colons, e.g. 'xx:xx:xx:xx:xx:xx'. The zero Addr returns '<nil>'.
+func (a Addr) String() string { return a.Format(constant.FmtColon) }
+
+// Format formats an Addr according to a string template, as MACAddress.Format does.
+func (a Addr) Format(f string) string {
+	if !a.IsValid() {
+		return constant.NilStr
+	}
+	return format.Template(a.Uint64(), f)
+}
+
+// MarshalText implements encoding.TextMarshaler, using the colon-separated format. The zero Addr
+// marshals to an empty string.
+func (a Addr) MarshalText() ([]byte, error) {
+	if !a.IsValid() {
+		return []byte{}, nil
+	}
+	return []byte(a.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the same input as ParseAddr. An
+// empty string unmarshals to the zero Addr.
+func (a *Addr) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*a = Addr{}
+		return nil
+	}
+	v, err := ParseAddr(string(text))
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
@@ -0,0 +1,135 @@
+package macaddr_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+func Test_ParseAddr(t *testing.T) {
+	t.Run("works", func(t *testing.T) {
+		t.Parallel()
+		a, err := macaddr.ParseAddr("00:00:5e:00:53:ab")
+		require.NoError(t, err)
+		assert.True(t, a.IsValid())
+		assert.Equal(t, "00:00:5e:00:53:ab", a.String())
+	})
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+		a, err := macaddr.ParseAddr("this should error")
+		require.Error(t, err)
+		assert.False(t, a.IsValid())
+	})
+	t.Run("MustParseAddr", func(t *testing.T) {
+		t.Parallel()
+		assert.Panics(t, func() { macaddr.MustParseAddr("this should panic") })
+	})
+}
+
+func Test_Addr(t *testing.T) {
+	a := macaddr.MustParseAddr("00:00:5e:00:53:ab")
+	t.Run("comparable", func(t *testing.T) {
+		t.Parallel()
+		assert.True(t, a == macaddr.MustParseAddr("00-00-5e-00-53-ab"))
+		m := map[macaddr.Addr]int{a: 1}
+		assert.Equal(t, 1, m[macaddr.AddrFrom6([6]byte{0, 0, 0x5e, 0, 0x53, 0xab})])
+		assert.Equal(t, uintptr(8), unsafe.Sizeof(a))
+	})
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+		var z macaddr.Addr
+		zero := macaddr.AddrFrom6([6]byte{})
+		assert.False(t, z.IsValid())
+		assert.True(t, zero.IsValid())
+		assert.NotEqual(t, z, zero)
+		assert.Equal(t, constant.NilStr, z.String())
+		assert.Nil(t, z.AsSlice())
+		assert.Nil(t, z.MACAddress())
+		assert.Nil(t, z.HardwareAddr())
+		assert.Equal(t, [6]byte{}, z.As6())
+		assert.False(t, z.Next().IsValid())
+		assert.False(t, z.Prev().IsValid())
+	})
+	t.Run("Compare()", func(t *testing.T) {
+		t.Parallel()
+		b := macaddr.MustParseAddr("00:00:5e:00:53:ac")
+		assert.Equal(t, -1, a.Compare(b))
+		assert.Equal(t, 1, b.Compare(a))
+		assert.Equal(t, 0, a.Compare(a))
+		assert.True(t, a.Less(b))
+		assert.True(t, macaddr.Addr{}.Less(macaddr.AddrFrom6([6]byte{})))
+	})
+	t.Run("As6() and AsSlice()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, [6]byte{0, 0, 0x5e, 0, 0x53, 0xab}, a.As6())
+		assert.Equal(t, []byte{0, 0, 0x5e, 0, 0x53, 0xab}, a.AsSlice())
+	})
+	t.Run("Uint64()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, uint64(0x00005e0053ab), a.Uint64())
+		assert.Equal(t, a, macaddr.AddrFromUint64(0xffff00005e0053ab))
+	})
+	t.Run("conversions", func(t *testing.T) {
+		t.Parallel()
+		m := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+		assert.Equal(t, m, a.MACAddress())
+		assert.Equal(t, a, m.Addr())
+		hw, _ := net.ParseMAC("00:00:5e:00:53:ab")
+		assert.Equal(t, hw, a.HardwareAddr())
+		b, ok := macaddr.AddrFromSlice(hw)
+		assert.True(t, ok)
+		assert.Equal(t, a, b)
+		_, ok = macaddr.AddrFromSlice([]byte{1, 2, 3})
+		assert.False(t, ok)
+		var n *macaddr.MACAddress
+		assert.False(t, n.Addr().IsValid())
+	})
+	t.Run("Next() and Prev()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "00:00:5e:00:53:ac", a.Next().String())
+		assert.Equal(t, "00:00:5e:00:53:aa", a.Prev().String())
+		assert.False(t, macaddr.MustParseAddr("ff:ff:ff:ff:ff:ff").Next().IsValid())
+		assert.False(t, macaddr.MustParseAddr("00:00:00:00:00:00").Prev().IsValid())
+	})
+	t.Run("Format()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "0000.5e00.53ab", a.Format(constant.FmtDot))
+	})
+}
+
+func Test_Addr_Text(t *testing.T) {
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+		in := map[string]macaddr.Addr{"a": macaddr.MustParseAddr("00:00:5e:00:53:ab"), "z": {}}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"a":"00:00:5e:00:53:ab","z":""}`, string(b))
+		var out map[string]macaddr.Addr
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+	})
+	t.Run("UnmarshalText() error", func(t *testing.T) {
+		t.Parallel()
+		var a macaddr.Addr
+		require.Error(t, a.UnmarshalText([]byte("this should error")))
+	})
+}
+
+func ExampleAddr() {
+	seen := map[macaddr.Addr]int{}
+	for _, s := range []string{"00:00:5e:00:53:ab", "00-00-5e-00-53-ab", "0000.5e00.53ac"} {
+		seen[macaddr.MustParseAddr(s)]++
+	}
+	fmt.Println(seen[macaddr.MustParseAddr("00:00:5e:00:53:ab")])
+	fmt.Println(len(seen))
+	// Output:
+	// 2
+	// 2
+}
@@ -0,0 +1,146 @@
+package macaddr
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+	"go.mdl.wtf/go-macaddr/internal/format"
+	"go.mdl.wtf/go-macaddr/internal/validate"
+)
+
+// AddrKind identifies the type of a hardware address, as determined by its length.
+type AddrKind int
+
+const (
+	// KindUnknown is a hardware address of an unsupported length.
+	KindUnknown AddrKind = iota
+	// KindEUI48 is a 48-bit (6 byte) address, represented by MACAddress.
+	KindEUI48
+	// KindEUI64 is a 64-bit (8 byte) address, represented by EUI64.
+	KindEUI64
+	// KindIPoIB is a 20 byte IP over InfiniBand link-layer address, represented by IPoIBAddress.
+	KindIPoIB
+)
+
+// KindFromLen returns the AddrKind of a hardware address with a length of n bytes.
+func KindFromLen(n int) AddrKind {
+	switch n {
+	case constant.MacByteLen:
+		return KindEUI48
+	case constant.EUI64ByteLen:
+		return KindEUI64
+	case constant.IPoIBByteLen:
+		return KindIPoIB
+	}
+	return KindUnknown
+}
+
+// String returns the name of the AddrKind, e.g. 'EUI-48'.
+func (k AddrKind) String() string {
+	switch k {
+	case KindEUI48:
+		return "EUI-48"
+	case KindEUI64:
+		return "EUI-64"
+	case KindIPoIB:
+		return "IPoIB"
+	}
+	return "unknown"
+}
+
+// Address is implemented by every supported hardware address type: *MACAddress, *EUI64 and
+// *IPoIBAddress.
+type Address interface {
+	fmt.Stringer
+	// Kind returns the detected type of the address.
+	Kind() AddrKind
+}
+
+// LengthError describes an input string that is a well-formed hardware address, but not of the
+// length the caller asked for. Addresses are never truncated or extended to fit. Parse functions
+// return a LengthError wrapped in a *ParseError; use errors.As to retrieve it.
+type LengthError struct {
+	// Input is the original input string.
+	Input string
+	// Kind is the detected type of the input.
+	Kind AddrKind
+	// Len is the detected number of bytes in the input.
+	Len int
+	// Want is the expected number of bytes, or 0 if any supported length was acceptable.
+	Want int
+}
+
+// Error implements the error interface.
+func (e *LengthError) Error() string {
+	if e.Want == 0 {
+		return fmt.Sprintf("'%v' is a %d byte hardware address, which is not supported", e.Input, e.Len)
+	}
+	if e.Kind == KindUnknown {
+		return fmt.Sprintf("'%v' is a %d byte hardware address, expected %d bytes", e.Input, e.Len, e.Want)
+	}
+	return fmt.Sprintf("'%v' is a %d byte %s address, expected %d bytes", e.Input, e.Len, e.Kind, e.Want)
+}
+
+// IPoIBAddress represents a single 20 byte IP over InfiniBand (RFC 4391) link-layer address.
+type IPoIBAddress []byte
+
+// Kind returns KindIPoIB.
+func (a *IPoIBAddress) Kind() AddrKind { return KindIPoIB }
+
+// String formats the IPoIBAddress with colons, e.g. 'xx:xx:...:xx'.
+func (a *IPoIBAddress) String() string {
+	if a == nil {
+		return constant.NilStr
+	}
+	return net.HardwareAddr(*a).String()
+}
+
+// Equal determines if an input IPoIBAddress is equal to this IPoIBAddress.
+func (a *IPoIBAddress) Equal(o *IPoIBAddress) bool {
+	if a == nil || o == nil {
+		return false
+	}
+	return bytes.Equal(*a, *o)
+}
+
+// Kind returns KindEUI48.
+func (m *MACAddress) Kind() AddrKind { return KindEUI48 }
+
+// Kind returns KindEUI64.
+func (e *EUI64) Kind() AddrKind { return KindEUI64 }
+
+// ParseAddress parses an input string to a hardware address of whichever supported length it
+// has, returning a *MACAddress, *EUI64 or *IPoIBAddress. Use Kind or a type switch to determine
+// which. Unlike ParseMACAddress, input is never padded: it must be a complete address. A
+// *ParseError wrapping a *LengthError is returned if the input is well-formed but of an
+// unsupported length.
+func ParseAddress(i string) (Address, error) {
+	if o := validate.FirstNonHex(i); o >= 0 {
+		return nil, errInvalidCharacter(i, o)
+	}
+	hw, err := net.ParseMAC(i)
+	if err != nil {
+		if !validate.BareHex(i) {
+			return nil, errInvalidFormat(i, err)
+		}
+		if len(i)%2 != 0 || KindFromLen(len(i)/2) == KindUnknown {
+			return nil, errInvalidLength(&LengthError{Input: i, Len: (len(i) + 1) / 2})
+		}
+		hw, err = net.ParseMAC(format.WithColons(i))
+		if err != nil {
+			return nil, errInvalidFormat(i, err)
+		}
+	}
+	switch KindFromLen(len(hw)) {
+	case KindEUI48:
+		return FromByteArray(hw), nil
+	case KindEUI64:
+		return EUI64FromByteArray(hw), nil
+	case KindIPoIB:
+		a := IPoIBAddress(hw)
+		return &a, nil
+	}
+	return nil, errInvalidLength(&LengthError{Input: i, Len: len(hw)})
+}
@@ -0,0 +1,137 @@
+package macaddr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+const ipoib = "00:00:00:48:fe:80:00:00:00:00:00:00:00:02:c9:03:00:0e:b9:41"
+
+func Test_ParseAddress(t *testing.T) {
+	type result struct {
+		in   string
+		kind macaddr.AddrKind
+		out  string
+	}
+	tests := []result{
+		{"00:00:5e:00:53:ab", macaddr.KindEUI48, "00:00:5e:00:53:ab"},
+		{"00005e0053ab", macaddr.KindEUI48, "00:00:5e:00:53:ab"},
+		{"02:00:5e:10:00:00:00:01", macaddr.KindEUI64, "02:00:5e:10:00:00:00:01"},
+		{"0200.5e10.0000.0001", macaddr.KindEUI64, "02:00:5e:10:00:00:00:01"},
+		{"02005e1000000001", macaddr.KindEUI64, "02:00:5e:10:00:00:00:01"},
+		{ipoib, macaddr.KindIPoIB, ipoib},
+	}
+	for i, p := range tests {
+		p := p
+		t.Run(fmt.Sprintf("parse %d", i+1), func(t *testing.T) {
+			t.Parallel()
+			a, err := macaddr.ParseAddress(p.in)
+			require.NoError(t, err)
+			assert.Equal(t, p.kind, a.Kind())
+			assert.Equal(t, p.out, a.String())
+		})
+	}
+	t.Run("concrete types", func(t *testing.T) {
+		t.Parallel()
+		a, _ := macaddr.ParseAddress("00:00:5e:00:53:ab")
+		assert.IsType(t, &macaddr.MACAddress{}, a)
+		a, _ = macaddr.ParseAddress("02:00:5e:10:00:00:00:01")
+		assert.IsType(t, &macaddr.EUI64{}, a)
+		a, _ = macaddr.ParseAddress(ipoib)
+		assert.IsType(t, &macaddr.IPoIBAddress{}, a)
+	})
+	t.Run("unsupported length", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.ParseAddress("00005e0053")
+		var le *macaddr.LengthError
+		require.True(t, errors.As(err, &le))
+		assert.Equal(t, 5, le.Len)
+		assert.Equal(t, macaddr.KindUnknown, le.Kind)
+	})
+	t.Run("no padding", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.ParseAddress("01:23:45")
+		require.Error(t, err)
+	})
+	t.Run("non-hex", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.ParseAddress("this should error")
+		require.Error(t, err)
+	})
+}
+
+func Test_LengthError(t *testing.T) {
+	t.Run("ParseMACAddress EUI-64", func(t *testing.T) {
+		t.Parallel()
+		m, err := macaddr.ParseMACAddress("02:00:5e:10:00:00:00:01")
+		assert.Nil(t, m)
+		var le *macaddr.LengthError
+		require.True(t, errors.As(err, &le))
+		assert.Equal(t, macaddr.KindEUI64, le.Kind)
+		assert.Equal(t, 8, le.Len)
+		assert.Equal(t, constant.MacByteLen, le.Want)
+		assert.Equal(t, "'02:00:5e:10:00:00:00:01' is a 8 byte EUI-64 address, expected 6 bytes", err.Error())
+	})
+	t.Run("ParseMACAddress bare EUI-64", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.ParseMACAddress("02005e1000000001")
+		var le *macaddr.LengthError
+		require.True(t, errors.As(err, &le))
+	})
+	t.Run("ParseMACAddress IPoIB", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.ParseMACAddress(ipoib)
+		var le *macaddr.LengthError
+		require.True(t, errors.As(err, &le))
+		assert.Equal(t, macaddr.KindIPoIB, le.Kind)
+	})
+	t.Run("ParseEUI64 EUI-48", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.ParseEUI64("00:00:5e:00:53:ab")
+		var le *macaddr.LengthError
+		require.True(t, errors.As(err, &le))
+		assert.Equal(t, macaddr.KindEUI48, le.Kind)
+	})
+	t.Run("unsupported message", func(t *testing.T) {
+		t.Parallel()
+		err := &macaddr.LengthError{Input: "0011", Len: 2}
+		assert.Equal(t, "'0011' is a 2 byte hardware address, which is not supported", err.Error())
+	})
+}
+
+func Test_AddrKind(t *testing.T) {
+	assert.Equal(t, "EUI-48", macaddr.KindFromLen(6).String())
+	assert.Equal(t, "EUI-64", macaddr.KindFromLen(8).String())
+	assert.Equal(t, "IPoIB", macaddr.KindFromLen(20).String())
+	assert.Equal(t, "unknown", macaddr.KindFromLen(7).String())
+}
+
+func Test_IPoIBAddress(t *testing.T) {
+	a, err := macaddr.ParseAddress(ipoib)
+	require.NoError(t, err)
+	ib := a.(*macaddr.IPoIBAddress)
+	b, _ := macaddr.ParseAddress(ipoib)
+	assert.True(t, ib.Equal(b.(*macaddr.IPoIBAddress)))
+	assert.False(t, ib.Equal(nil))
+	var n *macaddr.IPoIBAddress
+	assert.Equal(t, constant.NilStr, n.String())
+}
+
+func ExampleParseAddress() {
+	for _, s := range []string{"00:00:5e:00:53:ab", "02:00:5e:10:00:00:00:01"} {
+		addr, err := macaddr.ParseAddress(s)
+		if err != nil {
+			panic(err)
+		}
+		fmt.Println(addr.Kind(), addr.String())
+	}
+	// Output:
+	// EUI-48 00:00:5e:00:53:ab
+	// EUI-64 02:00:5e:10:00:00:00:01
+}
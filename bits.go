@@ -0,0 +1,137 @@
+package macaddr
+
+import (
+	"go.mdl.wtf/go-macaddr/internal/constant"
+	"go.mdl.wtf/go-macaddr/internal/read"
+)
+
+// IsMulticast determines if the individual/group (I/G) bit of the MACAddress is set, i.e. the
+// MACAddress is a group address. The broadcast address is also a multicast address.
+func (m *MACAddress) IsMulticast() bool {
+	if m == nil || len(*m) == 0 {
+		return false
+	}
+	return (*m)[0]&constant.GroupBit != 0
+}
+
+// IsUnicast determines if the individual/group (I/G) bit of the MACAddress is clear, i.e. the
+// MACAddress is an individual address.
+func (m *MACAddress) IsUnicast() bool {
+	if m == nil || len(*m) == 0 {
+		return false
+	}
+	return (*m)[0]&constant.GroupBit == 0
+}
+
+// IsLocal determines if the universal/local (U/L) bit of the MACAddress is set, i.e. the
+// MACAddress is locally administered.
+func (m *MACAddress) IsLocal() bool {
+	if m == nil || len(*m) == 0 {
+		return false
+	}
+	return (*m)[0]&constant.LocalBit != 0
+}
+
+// IsUniversal determines if the universal/local (U/L) bit of the MACAddress is clear, i.e. the
+// MACAddress is universally administered.
+func (m *MACAddress) IsUniversal() bool {
+	if m == nil || len(*m) == 0 {
+		return false
+	}
+	return (*m)[0]&constant.LocalBit == 0
+}
+
+// IsBroadcast determines if the MACAddress is the broadcast address, ff:ff:ff:ff:ff:ff.
+func (m *MACAddress) IsBroadcast() bool {
+	if m == nil || len(*m) != constant.MacByteLen {
+		return false
+	}
+	return read.IsAllF(*m)
+}
+
+// IsZero determines if the MACAddress is the all-zeros address, 00:00:00:00:00:00.
+func (m *MACAddress) IsZero() bool {
+	if m == nil || len(*m) != constant.MacByteLen {
+		return false
+	}
+	return read.IsZero(*m)
+}
+
+// setBit returns a copy of the MACAddress with bit b of the first octet set or cleared.
+func (m *MACAddress) setBit(b byte, set bool) *MACAddress {
+	if m == nil || len(*m) == 0 {
+		return nil
+	}
+	mac := make(MACAddress, len(*m))
+	copy(mac, *m)
+	if set {
+		mac[0] |= b
+	} else {
+		mac[0] &^= b
+	}
+	return &mac
+}
+
+// SetMulticast returns a copy of the MACAddress with the individual/group (I/G) bit set if
+// multicast is true, or cleared if multicast is false.
+func (m *MACAddress) SetMulticast(multicast bool) *MACAddress {
+	return m.setBit(constant.GroupBit, multicast)
+}
+
+// SetLocal returns a copy of the MACAddress with the universal/local (U/L) bit set if local is
+// true, or cleared if local is false.
+func (m *MACAddress) SetLocal(local bool) *MACAddress {
+	return m.setBit(constant.LocalBit, local)
+}
+
+// covers determines if the MACPrefix is valid and its prefix length is at least l, i.e. every
+// address in the MACPrefix shares its first l bits.
+func (p *MACPrefix) covers(l int) bool {
+	return p != nil && p.MAC != nil && p.Mask != nil && p.PrefixLen() >= l
+}
+
+// IsMulticast determines if every address in the MACPrefix is a multicast address, i.e. the
+// prefix length covers the individual/group (I/G) bit and the bit is set.
+func (p *MACPrefix) IsMulticast() bool {
+	return p.covers(8) && p.MAC.IsMulticast()
+}
+
+// IsUnicast determines if every address in the MACPrefix is a unicast address, i.e. the prefix
+// length covers the individual/group (I/G) bit and the bit is clear.
+func (p *MACPrefix) IsUnicast() bool {
+	return p.covers(8) && p.MAC.IsUnicast()
+}
+
+// IsLocal determines if every address in the MACPrefix is locally administered, i.e. the prefix
+// length covers the universal/local (U/L) bit and the bit is set.
+func (p *MACPrefix) IsLocal() bool {
+	return p.covers(7) && p.MAC.IsLocal()
+}
+
+// IsUniversal determines if every address in the MACPrefix is universally administered, i.e. the
+// prefix length covers the universal/local (U/L) bit and the bit is clear.
+func (p *MACPrefix) IsUniversal() bool {
+	return p.covers(7) && p.MAC.IsUniversal()
+}
+
+// firstOctet returns the first octet of a valid Addr.
+func (a Addr) firstOctet() byte { return byte(a.Uint64() >> (constant.MacBitLen - 8)) }
+
+// IsMulticast determines if the individual/group (I/G) bit of the Addr is set.
+func (a Addr) IsMulticast() bool { return a.IsValid() && a.firstOctet()&constant.GroupBit != 0 }
+
+// IsUnicast determines if the individual/group (I/G) bit of the Addr is clear.
+func (a Addr) IsUnicast() bool { return a.IsValid() && a.firstOctet()&constant.GroupBit == 0 }
+
+// IsLocal determines if the universal/local (U/L) bit of the Addr is set.
+func (a Addr) IsLocal() bool { return a.IsValid() && a.firstOctet()&constant.LocalBit != 0 }
+
+// IsUniversal determines if the universal/local (U/L) bit of the Addr is clear.
+func (a Addr) IsUniversal() bool { return a.IsValid() && a.firstOctet()&constant.LocalBit == 0 }
+
+// IsBroadcast determines if the Addr is the broadcast address, ff:ff:ff:ff:ff:ff.
+func (a Addr) IsBroadcast() bool { return a.IsValid() && a.Uint64() == addrBits }
+
+// IsZero determines if the Addr is the all-zeros address, 00:00:00:00:00:00. The zero Addr is
+// not valid, and is not the all-zeros address.
+func (a Addr) IsZero() bool { return a.IsValid() && a.Uint64() == 0 }
@@ -0,0 +1,127 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_MACAddress_Bits(t *testing.T) {
+	type result struct {
+		mac                                               string
+		multicast, unicast, local, universal, bcast, zero bool
+	}
+	tests := []result{
+		{"00:00:5e:00:53:ab", false, true, false, true, false, false},
+		{"01:00:5e:00:53:ab", true, false, false, true, false, false},
+		{"02:00:5e:00:53:ab", false, true, true, false, false, false},
+		{"03:00:5e:00:53:ab", true, false, true, false, false, false},
+		{"ff:ff:ff:ff:ff:ff", true, false, true, false, true, false},
+		{"00:00:00:00:00:00", false, true, false, true, false, true},
+	}
+	for _, r := range tests {
+		r := r
+		t.Run(r.mac, func(t *testing.T) {
+			t.Parallel()
+			m := macaddr.MustParseMACAddress(r.mac)
+			assert.Equal(t, r.multicast, m.IsMulticast())
+			assert.Equal(t, r.unicast, m.IsUnicast())
+			assert.Equal(t, r.local, m.IsLocal())
+			assert.Equal(t, r.universal, m.IsUniversal())
+			assert.Equal(t, r.bcast, m.IsBroadcast())
+			assert.Equal(t, r.zero, m.IsZero())
+			a := m.Addr()
+			assert.Equal(t, r.multicast, a.IsMulticast())
+			assert.Equal(t, r.unicast, a.IsUnicast())
+			assert.Equal(t, r.local, a.IsLocal())
+			assert.Equal(t, r.universal, a.IsUniversal())
+			assert.Equal(t, r.bcast, a.IsBroadcast())
+			assert.Equal(t, r.zero, a.IsZero())
+		})
+	}
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		var m *macaddr.MACAddress
+		assert.False(t, m.IsMulticast())
+		assert.False(t, m.IsUnicast())
+		assert.False(t, m.IsLocal())
+		assert.False(t, m.IsUniversal())
+		assert.False(t, m.IsBroadcast())
+		assert.False(t, m.IsZero())
+		assert.Nil(t, m.SetLocal(true))
+		assert.Nil(t, m.SetMulticast(true))
+		var a macaddr.Addr
+		assert.False(t, a.IsUnicast())
+		assert.False(t, a.IsZero())
+	})
+}
+
+func Test_MACAddress_SetBits(t *testing.T) {
+	m := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+	t.Run("SetLocal()", func(t *testing.T) {
+		t.Parallel()
+		l := m.SetLocal(true)
+		assert.Equal(t, "02:00:5e:00:53:ab", l.String())
+		assert.Equal(t, "00:00:5e:00:53:ab", l.SetLocal(false).String())
+		assert.Equal(t, "00:00:5e:00:53:ab", m.String())
+	})
+	t.Run("SetMulticast()", func(t *testing.T) {
+		t.Parallel()
+		g := m.SetMulticast(true)
+		assert.Equal(t, "01:00:5e:00:53:ab", g.String())
+		assert.Equal(t, "00:00:5e:00:53:ab", g.SetMulticast(false).String())
+	})
+}
+
+func Test_MACPrefix_Bits(t *testing.T) {
+	type result struct {
+		prefix                               string
+		multicast, unicast, local, universal bool
+	}
+	tests := []result{
+		{"00:00:5e:00:00:00/24", false, true, false, true},
+		{"01:00:5e:00:00:00/25", true, false, false, true},
+		{"02:00:00:00:00:00/7", false, false, true, false},
+		{"02:00:00:00:00:00/8", false, true, true, false},
+		{"00:00:00:00:00:00/6", false, false, false, false},
+	}
+	for _, r := range tests {
+		r := r
+		t.Run(r.prefix, func(t *testing.T) {
+			t.Parallel()
+			_, p := macaddr.MustParseMACPrefix(r.prefix)
+			assert.Equal(t, r.multicast, p.IsMulticast())
+			assert.Equal(t, r.unicast, p.IsUnicast())
+			assert.Equal(t, r.local, p.IsLocal())
+			assert.Equal(t, r.universal, p.IsUniversal())
+		})
+	}
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		var p *macaddr.MACPrefix
+		assert.False(t, p.IsLocal())
+		assert.False(t, p.IsMulticast())
+	})
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+		var p macaddr.MACPrefix
+		assert.False(t, p.IsMulticast())
+		assert.False(t, p.IsUnicast())
+		assert.False(t, p.IsLocal())
+		assert.False(t, p.IsUniversal())
+		require.NoError(t, p.Scan(nil))
+		assert.False(t, p.IsLocal())
+	})
+}
+
+func ExampleMACAddress_IsLocal() {
+	mac := macaddr.MustParseMACAddress("02:00:5e:00:53:ab")
+	fmt.Println(mac.IsLocal())
+	fmt.Println(mac.SetLocal(false).IsLocal())
+	// Output:
+	// true
+	// false
+}
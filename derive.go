@@ -0,0 +1,85 @@
+package macaddr
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+)
+
+// maxDeriveAttempts is the number of attempts Deriver.DeriveUnique makes before giving up.
+const maxDeriveAttempts = 256
+
+// Deriver derives stable, locally administered unicast MAC addresses from names, e.g. a pod
+// namespace and name, a VM UUID or a serial number, so that the same name always receives the
+// same address without a central database.
+//
+// # Algorithm
+//
+// The derivation is designed to be reproducible in any language:
+//
+//  1. The message is the name. For attempt i > 0, the message is the name, followed by a zero
+//     byte, followed by i as a 4 byte big-endian integer.
+//  2. The digest is HMAC-SHA256 of the message, keyed with Key.
+//  3. The first 6 bytes of the digest, as a big-endian integer, are masked to the host bits of
+//     Prefix (every bit, if Prefix is nil) and combined with the base address of Prefix.
+//  4. The universal/local (U/L) bit is set and the individual/group (I/G) bit is cleared.
+type Deriver struct {
+	// Key is the HMAC key. Use a distinct key per deployment to make addresses unpredictable to
+	// anyone without the key.
+	Key []byte
+	// Prefix restricts derived addresses to a MACPrefix. If the prefix length covers the I/G or
+	// U/L bits, the prefix must be locally administered and unicast.
+	Prefix *MACPrefix
+}
+
+// deriveMessage returns the HMAC message for a name and attempt.
+func deriveMessage(name string, attempt uint32) []byte {
+	if attempt == 0 {
+		return []byte(name)
+	}
+	msg := make([]byte, 0, len(name)+5)
+	msg = append(msg, name...)
+	msg = append(msg, 0)
+	return binary.BigEndian.AppendUint32(msg, attempt)
+}
+
+// DeriveAttempt derives the address for a name on a specific attempt. Attempt 0 is the address
+// returned by Derive; later attempts are alternatives used to resolve collisions.
+func (d *Deriver) DeriveAttempt(name string, attempt uint32) (Addr, error) {
+	g := Generator{Prefix: d.Prefix}
+	value, mask, err := g.constraints()
+	if err != nil {
+		return Addr{}, err
+	}
+	h := hmac.New(sha256.New, d.Key)
+	h.Write(deriveMessage(name, attempt))
+	var b [8]byte
+	copy(b[2:], h.Sum(nil))
+	return AddrFromUint64(value | binary.BigEndian.Uint64(b[:])&^mask), nil
+}
+
+// Derive derives the address for a name.
+func (d *Deriver) Derive(name string) (*MACAddress, error) {
+	a, err := d.DeriveAttempt(name, 0)
+	if err != nil {
+		return nil, err
+	}
+	return a.MACAddress(), nil
+}
+
+// DeriveUnique derives the address for a name, skipping addresses for which inUse returns true
+// by trying successive attempts. The address and the attempt that produced it are returned. An
+// error matching ErrExhausted is returned if no free address is found.
+func (d *Deriver) DeriveUnique(name string, inUse func(Addr) bool) (*MACAddress, uint32, error) {
+	for i := uint32(0); i < maxDeriveAttempts; i++ {
+		a, err := d.DeriveAttempt(name, i)
+		if err != nil {
+			return nil, 0, err
+		}
+		if inUse == nil || !inUse(a) {
+			return a.MACAddress(), i, nil
+		}
+	}
+	return nil, 0, fmt.Errorf("failed to derive an address for '%s' after %d attempts: %w", name, maxDeriveAttempts, ErrExhausted)
+}
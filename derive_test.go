@@ -0,0 +1,89 @@
+package macaddr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_Deriver(t *testing.T) {
+	key := []byte("secret")
+	_, p := macaddr.MustParseMACPrefix("02:00:5e:10:00:00/28")
+	t.Run("test vectors", func(t *testing.T) {
+		t.Parallel()
+		d := macaddr.Deriver{Key: key}
+		a, err := d.DeriveAttempt("default/web-0", 0)
+		require.NoError(t, err)
+		assert.Equal(t, "e2:ae:5a:94:3c:03", a.String())
+		a, err = d.DeriveAttempt("default/web-0", 1)
+		require.NoError(t, err)
+		assert.Equal(t, "7a:94:44:d1:40:e0", a.String())
+		d.Prefix = p
+		mac, err := d.Derive("default/web-0")
+		require.NoError(t, err)
+		assert.Equal(t, "02:00:5e:14:3c:03", mac.String())
+	})
+	t.Run("stable", func(t *testing.T) {
+		t.Parallel()
+		d := macaddr.Deriver{Key: key, Prefix: p}
+		a, err := d.Derive("vm-6f1c2c7e")
+		require.NoError(t, err)
+		b, err := d.Derive("vm-6f1c2c7e")
+		require.NoError(t, err)
+		assert.True(t, a.Equal(b))
+		assert.True(t, p.Contains(a))
+		assert.True(t, a.IsLocal())
+		assert.True(t, a.IsUnicast())
+		c, err := (&macaddr.Deriver{Key: []byte("other"), Prefix: p}).Derive("vm-6f1c2c7e")
+		require.NoError(t, err)
+		assert.False(t, a.Equal(c))
+	})
+	t.Run("prefix conflicts", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range []string{"00:00:5e:00:00:00/24", "03:00:5e:00:00:00/24"} {
+			_, q := macaddr.MustParseMACPrefix(s)
+			_, err := (&macaddr.Deriver{Key: key, Prefix: q}).Derive("web-0")
+			assert.Error(t, err, s)
+			_, _, err = (&macaddr.Deriver{Key: key, Prefix: q}).DeriveUnique("web-0", nil)
+			assert.Error(t, err, s)
+		}
+	})
+	t.Run("DeriveUnique()", func(t *testing.T) {
+		t.Parallel()
+		d := macaddr.Deriver{Key: key, Prefix: p}
+		first, err := d.DeriveAttempt("web-0", 0)
+		require.NoError(t, err)
+		mac, attempt, err := d.DeriveUnique("web-0", func(a macaddr.Addr) bool { return a == first })
+		require.NoError(t, err)
+		assert.Equal(t, uint32(1), attempt)
+		second, err := d.DeriveAttempt("web-0", 1)
+		require.NoError(t, err)
+		assert.Equal(t, second, mac.Addr())
+		mac, attempt, err = d.DeriveUnique("web-0", nil)
+		require.NoError(t, err)
+		assert.Zero(t, attempt)
+		assert.Equal(t, first, mac.Addr())
+	})
+	t.Run("DeriveUnique() exhausted", func(t *testing.T) {
+		t.Parallel()
+		d := macaddr.Deriver{Key: key, Prefix: p}
+		_, _, err := d.DeriveUnique("web-0", func(macaddr.Addr) bool { return true })
+		assert.True(t, errors.Is(err, macaddr.ErrExhausted))
+	})
+}
+
+func ExampleDeriver() {
+	_, p := macaddr.MustParseMACPrefix("02:00:5e:10:00:00/28")
+	d := macaddr.Deriver{Key: []byte("secret"), Prefix: p}
+	mac, err := d.Derive("default/web-0")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(mac.String())
+	// Output:
+	// 02:00:5e:14:3c:03
+}
@@ -0,0 +1,39 @@
+package macaddr
+
+import (
+	"fmt"
+	"net/netip"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// DockerMAC returns the MACAddress Docker's default bridge network derives from a container's
+// IPv4 address: 02:42 followed by the four octets of the address. For example, 172.17.0.2
+// becomes 02:42:ac:11:00:02. IPv4-mapped IPv6 addresses are accepted.
+func DockerMAC(a netip.Addr) (*MACAddress, error) {
+	a = a.Unmap()
+	if !a.Is4() {
+		return nil, fmt.Errorf("'%s' is not an IPv4 address", a)
+	}
+	b := a.As4()
+	return FromBytes(0x02, 0x42, b[0], b[1], b[2], b[3]), nil
+}
+
+// IsDocker determines if the MACAddress is within 02:42:00:00:00:00/16, the range used by
+// Docker's default bridge network.
+func (m *MACAddress) IsDocker() bool {
+	if m == nil || len(*m) != constant.MacByteLen {
+		return false
+	}
+	return (*m)[0] == 0x02 && (*m)[1] == 0x42
+}
+
+// DockerIP recovers the container IPv4 address from which Docker's default bridge network derived
+// the MACAddress. If the MACAddress is not within 02:42:00:00:00:00/16, DockerIP returns false.
+func (m *MACAddress) DockerIP() (netip.Addr, bool) {
+	if !m.IsDocker() {
+		return netip.Addr{}, false
+	}
+	b := *m
+	return netip.AddrFrom4([4]byte{b[2], b[3], b[4], b[5]}), true
+}
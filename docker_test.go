@@ -0,0 +1,65 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_DockerMAC(t *testing.T) {
+	t.Run("IPv4", func(t *testing.T) {
+		t.Parallel()
+		mac, err := macaddr.DockerMAC(netip.MustParseAddr("172.17.0.2"))
+		require.NoError(t, err)
+		assert.Equal(t, "02:42:ac:11:00:02", mac.String())
+		assert.True(t, mac.IsDocker())
+		assert.Equal(t, macaddr.PlatformDocker, mac.Platform())
+	})
+	t.Run("IPv4-mapped IPv6", func(t *testing.T) {
+		t.Parallel()
+		mac, err := macaddr.DockerMAC(netip.MustParseAddr("::ffff:192.0.2.1"))
+		require.NoError(t, err)
+		assert.Equal(t, "02:42:c0:00:02:01", mac.String())
+	})
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.DockerMAC(netip.MustParseAddr("2001:db8::1"))
+		assert.Error(t, err)
+		_, err = macaddr.DockerMAC(netip.Addr{})
+		assert.Error(t, err)
+	})
+}
+
+func Test_MACAddress_DockerIP(t *testing.T) {
+	t.Run("Docker", func(t *testing.T) {
+		t.Parallel()
+		a, ok := macaddr.MustParseMACAddress("02:42:ac:11:00:02").DockerIP()
+		assert.True(t, ok)
+		assert.Equal(t, "172.17.0.2", a.String())
+	})
+	t.Run("not Docker", func(t *testing.T) {
+		t.Parallel()
+		m := macaddr.MustParseMACAddress("02:43:ac:11:00:02")
+		assert.False(t, m.IsDocker())
+		_, ok := m.DockerIP()
+		assert.False(t, ok)
+		var n *macaddr.MACAddress
+		assert.False(t, n.IsDocker())
+		_, ok = n.DockerIP()
+		assert.False(t, ok)
+	})
+}
+
+func ExampleDockerMAC() {
+	mac, _ := macaddr.DockerMAC(netip.MustParseAddr("172.17.0.2"))
+	fmt.Println(mac.String())
+	ip, _ := mac.DockerIP()
+	fmt.Println(ip)
+	// Output:
+	// 02:42:ac:11:00:02
+	// 172.17.0.2
+}
@@ -0,0 +1,130 @@
+package macaddr
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorKind identifies the reason input could not be parsed.
+type ErrorKind int
+
+const (
+	// ErrorInvalidCharacter is input containing a non-hexadecimal character.
+	ErrorInvalidCharacter ErrorKind = iota + 1
+	// ErrorInvalidFormat is input with an unrecognized arrangement of separators and digits.
+	ErrorInvalidFormat
+	// ErrorInvalidLength is input containing the wrong number of bytes.
+	ErrorInvalidLength
+	// ErrorInvalidPrefixLen is input with a missing or out of range prefix length.
+	ErrorInvalidPrefixLen
+	// ErrorNotContained is input that is not contained within a MACPrefix.
+	ErrorNotContained
+)
+
+// Sentinel errors matching each ErrorKind, for use with errors.Is.
+var (
+	ErrInvalidCharacter = errors.New("invalid character")
+	ErrInvalidFormat    = errors.New("invalid format")
+	ErrInvalidLength    = errors.New("invalid length")
+	ErrInvalidPrefixLen = errors.New("invalid prefix length")
+	ErrNotContained     = errors.New("not contained within prefix")
+)
+
+var errorKindSentinels = map[ErrorKind]error{
+	ErrorInvalidCharacter: ErrInvalidCharacter,
+	ErrorInvalidFormat:    ErrInvalidFormat,
+	ErrorInvalidLength:    ErrInvalidLength,
+	ErrorInvalidPrefixLen: ErrInvalidPrefixLen,
+	ErrorNotContained:     ErrNotContained,
+}
+
+// String returns a description of the ErrorKind, e.g. 'invalid character'.
+func (k ErrorKind) String() string {
+	if err, ok := errorKindSentinels[k]; ok {
+		return err.Error()
+	}
+	return "unknown error"
+}
+
+// ParseError is returned when input cannot be parsed to an address or prefix.
+type ParseError struct {
+	// Kind is the reason the input could not be parsed.
+	Kind ErrorKind
+	// Input is the original input string.
+	Input string
+	// Offset is the byte offset in Input at which the error was detected, or -1 if the error
+	// applies to the input as a whole.
+	Offset int
+	// Err is the underlying error, if any, e.g. a *LengthError.
+	Err error
+	// prefix is the MACPrefix input was matched against, for ErrorNotContained.
+	prefix *MACPrefix
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	switch e.Kind {
+	case ErrorInvalidCharacter:
+		if e.Offset >= 0 && e.Offset < len(e.Input) {
+			return fmt.Sprintf("'%v' contains non-hexadecimal character '%c' at offset %d", e.Input, e.Input[e.Offset], e.Offset)
+		}
+	case ErrorInvalidFormat:
+		return fmt.Sprintf("'%v' is an invalid hardware address", e.Input)
+	case ErrorInvalidPrefixLen:
+		return fmt.Sprintf("'%v' is an invalid MAC prefix", e.Input)
+	case ErrorNotContained:
+		return fmt.Sprintf("'%v' is not contained within MACPrefix %s", e.Input, e.prefix.String())
+	}
+	if e.Err != nil {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("'%v' has an %s", e.Input, e.Kind)
+}
+
+// Unwrap returns the underlying error, if any.
+func (e *ParseError) Unwrap() error { return e.Err }
+
+// Is reports whether target is the sentinel error of the ParseError's Kind, e.g.
+// ErrInvalidCharacter.
+func (e *ParseError) Is(target error) bool {
+	return target != nil && errorKindSentinels[e.Kind] == target
+}
+
+// Is reports whether target is ErrInvalidLength.
+func (e *LengthError) Is(target error) bool { return target == ErrInvalidLength }
+
+// errInvalidCharacter creates a ParseError for input containing a non-hexadecimal character at
+// offset o.
+func errInvalidCharacter(i string, o int) *ParseError {
+	return &ParseError{Kind: ErrorInvalidCharacter, Input: i, Offset: o}
+}
+
+// errInvalidFormat creates a ParseError for input that could not be parsed by net.ParseMAC.
+func errInvalidFormat(i string, err error) *ParseError {
+	return &ParseError{Kind: ErrorInvalidFormat, Input: i, Offset: -1, Err: err}
+}
+
+// errInvalidLength creates a ParseError from a LengthError.
+func errInvalidLength(le *LengthError) *ParseError {
+	return &ParseError{Kind: ErrorInvalidLength, Input: le.Input, Offset: -1, Err: le}
+}
+
+// errInvalidPrefixLen creates a ParseError for a prefix length starting at offset o.
+func errInvalidPrefixLen(i string, o int) *ParseError {
+	return &ParseError{Kind: ErrorInvalidPrefixLen, Input: i, Offset: o}
+}
+
+// errNotContained creates a ParseError for input not contained within a MACPrefix.
+func errNotContained(i string, p *MACPrefix) *ParseError {
+	return &ParseError{Kind: ErrorNotContained, Input: i, Offset: -1, prefix: p}
+}
+
+// withInput replaces the Input of a ParseError, e.g. when only part of the original input was
+// parsed.
+func withInput(err error, i string) error {
+	var pe *ParseError
+	if errors.As(err, &pe) {
+		pe.Input = i
+	}
+	return err
+}
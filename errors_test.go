@@ -0,0 +1,195 @@
+package macaddr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_ParseError(t *testing.T) {
+	type result struct {
+		name     string
+		err      error
+		kind     macaddr.ErrorKind
+		sentinel error
+		offset   int
+		msg      string
+	}
+	parseMAC := func(s string) error {
+		_, err := macaddr.ParseMACAddress(s)
+		return err
+	}
+	parseEUI := func(s string) error {
+		_, err := macaddr.ParseEUI64(s)
+		return err
+	}
+	parseAddr := func(s string) error {
+		_, err := macaddr.ParseAddress(s)
+		return err
+	}
+	parsePrefix := func(s string) error {
+		_, _, err := macaddr.ParseMACPrefix(s)
+		return err
+	}
+	_, prefix := macaddr.MustParseMACPrefix("00:00:5e:00:00:00/24")
+	match := func(s string) error {
+		_, err := prefix.Match(s)
+		return err
+	}
+	tests := []result{
+		{
+			"non-hex", parseMAC("0123.4567.89az"),
+			macaddr.ErrorInvalidCharacter, macaddr.ErrInvalidCharacter, 13,
+			"'0123.4567.89az' contains non-hexadecimal character 'z' at offset 13",
+		},
+		{
+			"punctuation", parseMAC("00:00:5e:00:53:a#"),
+			macaddr.ErrorInvalidCharacter, macaddr.ErrInvalidCharacter, 16,
+			"'00:00:5e:00:53:a#' contains non-hexadecimal character '#' at offset 16",
+		},
+		{
+			"separator", parseMAC("00#00#5e#00#53#ab"),
+			macaddr.ErrorInvalidCharacter, macaddr.ErrInvalidCharacter, 2,
+			"'00#00#5e#00#53#ab' contains non-hexadecimal character '#' at offset 2",
+		},
+		{
+			"too long", parseMAC("0123456789abcdef0123456789abcdef"),
+			macaddr.ErrorInvalidLength, macaddr.ErrInvalidLength, -1,
+			"'0123456789abcdef0123456789abcdef' is a 16 byte hardware address, expected 6 bytes",
+		},
+		{
+			"EUI-64", parseMAC("02:00:5e:10:00:00:00:01"),
+			macaddr.ErrorInvalidLength, macaddr.ErrInvalidLength, -1,
+			"'02:00:5e:10:00:00:00:01' is a 8 byte EUI-64 address, expected 6 bytes",
+		},
+		{
+			"EUI-64 non-hex", parseEUI("02:00:5e:10:00:00:00:0x"),
+			macaddr.ErrorInvalidCharacter, macaddr.ErrInvalidCharacter, 22,
+			"'02:00:5e:10:00:00:00:0x' contains non-hexadecimal character 'x' at offset 22",
+		},
+		{
+			"EUI-64 too long", parseEUI("0123456789abcdef0123"),
+			macaddr.ErrorInvalidLength, macaddr.ErrInvalidLength, -1,
+			"'0123456789abcdef0123' is a 10 byte hardware address, expected 8 bytes",
+		},
+		{
+			"address non-hex", parseAddr("00:00:5e:00:53:ag"),
+			macaddr.ErrorInvalidCharacter, macaddr.ErrInvalidCharacter, 16,
+			"'00:00:5e:00:53:ag' contains non-hexadecimal character 'g' at offset 16",
+		},
+		{
+			"address bad format", parseAddr("00:00:5e"),
+			macaddr.ErrorInvalidFormat, macaddr.ErrInvalidFormat, -1,
+			"'00:00:5e' is an invalid hardware address",
+		},
+		{
+			"prefix non-hex", parsePrefix("00:00:5e:00:53:ab/2x"),
+			macaddr.ErrorInvalidCharacter, macaddr.ErrInvalidCharacter, 19,
+			"'00:00:5e:00:53:ab/2x' contains non-hexadecimal character 'x' at offset 19",
+		},
+		{
+			"prefix punctuation", parsePrefix("00:00:5e:00:53:a#/24"),
+			macaddr.ErrorInvalidCharacter, macaddr.ErrInvalidCharacter, 16,
+			"'00:00:5e:00:53:a#/24' contains non-hexadecimal character '#' at offset 16",
+		},
+		{
+			"prefix length", parsePrefix("00:00:5e:00:53:ab/64"),
+			macaddr.ErrorInvalidPrefixLen, macaddr.ErrInvalidPrefixLen, 18,
+			"'00:00:5e:00:53:ab/64' is an invalid MAC prefix",
+		},
+		{
+			"prefix length hex", parsePrefix("00:00:5e:00:00:00/ab"),
+			macaddr.ErrorInvalidPrefixLen, macaddr.ErrInvalidPrefixLen, 18,
+			"'00:00:5e:00:00:00/ab' is an invalid MAC prefix",
+		},
+		{
+			"prefix length empty", parsePrefix("00:00:5e:00:00:00/"),
+			macaddr.ErrorInvalidPrefixLen, macaddr.ErrInvalidPrefixLen, 18,
+			"'00:00:5e:00:00:00/' is an invalid MAC prefix",
+		},
+		{
+			"prefix length extra slash", parsePrefix("00:00:5e:00:00:00/24/3"),
+			macaddr.ErrorInvalidPrefixLen, macaddr.ErrInvalidPrefixLen, 18,
+			"'00:00:5e:00:00:00/24/3' is an invalid MAC prefix",
+		},
+		{
+			"prefix address", parsePrefix("02:00:5e:10:00:00:00:01/24"),
+			macaddr.ErrorInvalidLength, macaddr.ErrInvalidLength, -1,
+			"'02:00:5e:10:00:00:00:01' is a 8 byte EUI-64 address, expected 6 bytes",
+		},
+		{
+			"match", match("00:00:5f:01:23:45"),
+			macaddr.ErrorNotContained, macaddr.ErrNotContained, -1,
+			"'00:00:5f:01:23:45' is not contained within MACPrefix 00:00:5e:00:00:00/24",
+		},
+		{
+			"match prefix length", match("00:00:5e:01:23:45/12"),
+			macaddr.ErrorNotContained, macaddr.ErrNotContained, -1,
+			"'00:00:5e:01:23:45/12' is not contained within MACPrefix 00:00:5e:00:00:00/24",
+		},
+		{
+			"match bad prefix length", match("00:00:5e:01:23:45/ab"),
+			macaddr.ErrorInvalidPrefixLen, macaddr.ErrInvalidPrefixLen, 18,
+			"'00:00:5e:01:23:45/ab' is an invalid MAC prefix",
+		},
+		{
+			"match prefix length too long", match("00:00:5e:01:23:45/64"),
+			macaddr.ErrorInvalidPrefixLen, macaddr.ErrInvalidPrefixLen, 18,
+			"'00:00:5e:01:23:45/64' is an invalid MAC prefix",
+		},
+		{
+			"match non-hex", match("this should error"),
+			macaddr.ErrorInvalidCharacter, macaddr.ErrInvalidCharacter, 0,
+			"'this should error' contains non-hexadecimal character 't' at offset 0",
+		},
+	}
+	for _, r := range tests {
+		r := r
+		t.Run(r.name, func(t *testing.T) {
+			t.Parallel()
+			var pe *macaddr.ParseError
+			require.True(t, errors.As(r.err, &pe), r.err)
+			assert.Equal(t, r.kind, pe.Kind)
+			assert.Equal(t, r.offset, pe.Offset)
+			assert.True(t, errors.Is(r.err, r.sentinel))
+			assert.Equal(t, r.msg, r.err.Error())
+		})
+	}
+	t.Run("prefix input", func(t *testing.T) {
+		t.Parallel()
+		var pe *macaddr.ParseError
+		require.True(t, errors.As(parsePrefix("02:00:5e:10:00:00:00:01/24"), &pe))
+		assert.Equal(t, "02:00:5e:10:00:00:00:01/24", pe.Input)
+	})
+	t.Run("sentinels are distinct", func(t *testing.T) {
+		t.Parallel()
+		err := parseMAC("0123.4567.89az")
+		assert.False(t, errors.Is(err, macaddr.ErrInvalidLength))
+		assert.False(t, errors.Is(err, nil))
+	})
+}
+
+func Test_ErrorKind(t *testing.T) {
+	assert.Equal(t, "invalid character", macaddr.ErrorInvalidCharacter.String())
+	assert.Equal(t, "unknown error", macaddr.ErrorKind(0).String())
+	err := &macaddr.ParseError{Input: "x", Offset: -1}
+	assert.Equal(t, "'x' has an unknown error", err.Error())
+	le := &macaddr.LengthError{Input: "0011", Len: 2}
+	assert.True(t, errors.Is(le, macaddr.ErrInvalidLength))
+}
+
+func ExampleParseError() {
+	_, err := macaddr.ParseMACAddress("00:00:5e:00:53:zz")
+	var pe *macaddr.ParseError
+	if errors.As(err, &pe) {
+		fmt.Println(pe.Kind, pe.Offset)
+	}
+	fmt.Println(errors.Is(err, macaddr.ErrInvalidCharacter))
+	// Output:
+	// invalid character 15
+	// true
+}
@@ -0,0 +1,230 @@
+package macaddr
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"strings"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+	"go.mdl.wtf/go-macaddr/internal/convert"
+	"go.mdl.wtf/go-macaddr/internal/format"
+	"go.mdl.wtf/go-macaddr/internal/read"
+	"go.mdl.wtf/go-macaddr/internal/validate"
+)
+
+// EUI64 represents a single 64-bit Extended Unique Identifier (EUI-64), a slice of bytes.
+type EUI64 []byte
+
+// ParseEUI64 parses an input string to a valid EUI64 object. Input that is a complete 48-bit or
+// 20 byte address is rejected with a *ParseError wrapping a *LengthError.
+func ParseEUI64(i string) (*EUI64, error) {
+	if o := validate.FirstNonHex(i); o >= 0 {
+		return nil, errInvalidCharacter(i, o)
+	}
+	hw, err := net.ParseMAC(i)
+	if err != nil {
+		h := format.PadEUI64(i)
+		hw, err = net.ParseMAC(format.WithColons(h))
+		if err != nil {
+			if len(h) > constant.EUI64HexStrLen {
+				return nil, errInvalidLength(&LengthError{Input: i, Len: (len(h) + 1) / 2, Want: constant.EUI64ByteLen})
+			}
+			return nil, errInvalidFormat(i, err)
+		}
+	}
+	if len(hw) != constant.EUI64ByteLen {
+		return nil, errInvalidLength(&LengthError{Input: i, Kind: KindFromLen(len(hw)), Len: len(hw), Want: constant.EUI64ByteLen})
+	}
+	return EUI64FromByteArray(hw), nil
+}
+
+// MustParseEUI64 operates identically to ParseEUI64, but panics on error instead of returning
+// the error. Most ideal for tests.
+func MustParseEUI64(i string) *EUI64 {
+	eui, err := ParseEUI64(i)
+	if err != nil {
+		panic(err)
+	}
+	return eui
+}
+
+// EUI64MaskFromPrefixLen creates an EUI64 mask from a prefix bit length. For example, a prefix
+// length of 24 would return ff:ff:ff:00:00:00:00:00.
+func EUI64MaskFromPrefixLen(l int) *EUI64 {
+	if l < 0 {
+		l = 0
+	}
+	if l > constant.EUI64BitLen {
+		l = constant.EUI64BitLen
+	}
+	var v uint64
+	if l > 0 {
+		v = ^uint64(0) << (constant.EUI64BitLen - l)
+	}
+	return eui64FromUint64(v)
+}
+
+// EUI64FromBytes creates an EUI64 object directly from bytes.
+func EUI64FromBytes(one, two, three, four, five, six, seven, eight byte) *EUI64 {
+	eui := make(EUI64, constant.EUI64ByteLen)
+	copy(eui, []byte{one, two, three, four, five, six, seven, eight})
+	return &eui
+}
+
+// EUI64FromByteArray creates an EUI64 object directly from a byte array.
+func EUI64FromByteArray(b []byte) *EUI64 {
+	return EUI64FromBytes(b[0], b[1], b[2], b[3], b[4], b[5], b[6], b[7])
+}
+
+// eui64FromUint64 creates an EUI64 object from the integer representation of an address.
+func eui64FromUint64(v uint64) *EUI64 {
+	eui := make(EUI64, constant.EUI64ByteLen)
+	for i := constant.EUI64ByteLen - 1; i >= 0; i-- {
+		eui[i] = byte(v)
+		v >>= 8
+	}
+	return &eui
+}
+
+// String formats the EUI64 with colons, e.g. 'xx:xx:xx:xx:xx:xx:xx:xx'.
+func (e *EUI64) String() string { return e.Format(constant.Fmt64Colon) }
+
+// Dots formats the EUI64 with dots, e.g. 'xxxx.xxxx.xxxx.xxxx'.
+func (e *EUI64) Dots() string { return e.Format(constant.Fmt64Dot) }
+
+// Dashes formats the EUI64 with dashes, e.g. 'xx-xx-xx-xx-xx-xx-xx-xx'.
+func (e *EUI64) Dashes() string { return e.Format(constant.Fmt64Dash) }
+
+// NoSeparators formats the EUI64 with no separators, e.g. 'xxxxxxxxxxxxxxxx'.
+func (e *EUI64) NoSeparators() string { return e.Format(constant.Fmt64None) }
+
+// Int returns an integer representation of an EUI64. Unlike MACAddress.Int, the result is
+// unsigned, as a 64-bit address may not fit in an int64.
+func (e *EUI64) Int() uint64 {
+	if e == nil {
+		return 0
+	}
+	return convert.ByteArrayToUint64(*e)
+}
+
+// ByteString returns a string representation of each EUI64 byte.
+func (e *EUI64) ByteString() string {
+	if e == nil {
+		return constant.NilStr
+	}
+	bsa := []string{}
+	for _, b := range *e {
+		bsa = append(bsa, fmt.Sprint(b))
+	}
+	return fmt.Sprintf("{%s}", strings.Join(bsa, ","))
+}
+
+// Clone creates an unlinked copy of the EUI64.
+func (e *EUI64) Clone() *EUI64 {
+	if e == nil {
+		return nil
+	}
+	return EUI64FromByteArray(*e)
+}
+
+// Next returns the next EUI64 after the current EUI64.
+func (e *EUI64) Next() *EUI64 {
+	if e == nil {
+		return nil
+	}
+	if read.IsAllF(*e) {
+		return e.Clone()
+	}
+	return eui64FromUint64(e.Int() + 1)
+}
+
+// Previous returns the previous EUI64 before the current EUI64.
+func (e *EUI64) Previous() *EUI64 {
+	if e == nil {
+		return nil
+	}
+	if read.IsZero(*e) {
+		return e.Clone()
+	}
+	return eui64FromUint64(e.Int() - 1)
+}
+
+// Mask returns the result of masking the EUI64 with the input mask (which is also an EUI64).
+func (e *EUI64) Mask(mask *EUI64) *EUI64 {
+	n := len(*e)
+	if n != len(*mask) {
+		return nil
+	}
+	eui := make(EUI64, n)
+	em := *e
+	mp := *mask
+	for i := 0; i < n; i++ {
+		eui[i] = em[i] & mp[i]
+	}
+	return &eui
+}
+
+// Equal determines if an input EUI64 is equal to this EUI64.
+func (e *EUI64) Equal(o *EUI64) bool {
+	if e == nil || o == nil {
+		return false
+	}
+	return bytes.Equal(*e, *o)
+}
+
+// GreaterThan determines if this EUI64 is greater than an input EUI64.
+func (e *EUI64) GreaterThan(o *EUI64) bool {
+	if e == nil || o == nil {
+		return false
+	}
+	return e.Int() > o.Int()
+}
+
+// LessThan determines if this EUI64 is less than an input EUI64.
+func (e *EUI64) LessThan(o *EUI64) bool {
+	if e == nil || o == nil {
+		return false
+	}
+	return e.Int() < o.Int()
+}
+
+// GEqual determines if this EUI64 is greater than or equal to an input EUI64.
+func (e *EUI64) GEqual(o *EUI64) bool {
+	return e.GreaterThan(o) || e.Equal(o)
+}
+
+// LEqual determines if this EUI64 is less than or equal to an input EUI64.
+func (e *EUI64) LEqual(o *EUI64) bool {
+	return e.LessThan(o) || e.Equal(o)
+}
+
+// Format formats an EUI64 according to a string template. For example, a template of
+// xxxx.xxxx.xxxx.xxxx and an EUI64 of 02:00:5e:10:00:00:00:01 would return a value of
+// 0200.5e10.0000.0001.
+func (e *EUI64) Format(f string) string {
+	if e == nil {
+		return constant.NilStr
+	}
+	return format.Template(e.Int(), f)
+}
+
+// OUI returns the Organizationally Unique Identifier (OUI) of an EUI64. If a prefix length is
+// provided, the EUI64 will be masked with this prefix length. If no prefix length is provided, a
+// 24 bit length is assumed.
+func (e *EUI64) OUI(l ...int) string {
+	pl := 24
+	if len(l) > 0 {
+		pl = l[0]
+	}
+	if e == nil {
+		return constant.NilStr
+	}
+	em := e.Mask(EUI64MaskFromPrefixLen(pl))
+
+	if pl <= 24 {
+		s := em.String()
+		return s[:constant.HexStrWithColonsLen/2]
+	}
+	return fmt.Sprintf("%s/%d", em.String(), pl)
+}
@@ -0,0 +1,160 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+	"go.mdl.wtf/go-macaddr/internal/constant"
+	"go.mdl.wtf/go-macaddr/internal/read"
+)
+
+func TestMustParseEUI64(t *testing.T) {
+	t.Run("panic", func(t *testing.T) {
+		assert.Panics(t, func() {
+			macaddr.MustParseEUI64("this should panic")
+		})
+	})
+	t.Run("no panic", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			macaddr.MustParseEUI64("02:00:5e:10:00:00:00:01")
+		})
+	})
+}
+
+func Test_ParseEUI64(t *testing.T) {
+	tests := [][]string{
+		{"02:00:5e:10:00:00:00:01", "02:00:5e:10:00:00:00:01"},
+		{"02-00-5e-10-00-00-00-01", "02:00:5e:10:00:00:00:01"},
+		{"0200.5e10.0000.0001", "02:00:5e:10:00:00:00:01"},
+		{"02005e1000000001", "02:00:5e:10:00:00:00:01"},
+		{"02:00:5e", "02:00:5e:00:00:00:00:00"},
+	}
+	for i, p := range tests {
+		e := p[1]
+		t.Run(fmt.Sprintf("parse %d", i+1), func(t *testing.T) {
+			t.Parallel()
+			r, err := macaddr.ParseEUI64(p[0])
+			require.NoError(t, err)
+			assert.Equal(t, e, r.String())
+		})
+	}
+	errs := []string{
+		"0200.5e10.0000.00az",
+		"0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+	}
+	for i, s := range errs {
+		t.Run(fmt.Sprintf("error %d", i+1), func(t *testing.T) {
+			t.Parallel()
+			_, err := macaddr.ParseEUI64(s)
+			require.Error(t, err)
+		})
+	}
+}
+
+func Test_EUI64(t *testing.T) {
+	s := "02:00:5e:10:00:00:00:01"
+	e := macaddr.MustParseEUI64(s)
+	t.Run("EUI64.Int()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, uint64(0x02005e1000000001), e.Int())
+		var n *macaddr.EUI64
+		assert.Equal(t, uint64(0), n.Int())
+	})
+	t.Run("EUI64.Int() high bit", func(t *testing.T) {
+		t.Parallel()
+		e := macaddr.MustParseEUI64("ff:ff:ff:ff:ff:ff:ff:fe")
+		assert.Equal(t, uint64(0xfffffffffffffffe), e.Int())
+	})
+	t.Run("EUI64 formats", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, s, e.String())
+		assert.Equal(t, "0200.5e10.0000.0001", e.Dots())
+		assert.Equal(t, "02-00-5e-10-00-00-00-01", e.Dashes())
+		assert.Equal(t, "02005e1000000001", e.NoSeparators())
+		assert.Equal(t, "02005e_10000000_01", e.Format("xxxxxx_xxxxxxxx_xx"))
+	})
+	t.Run("EUI64 nil formats", func(t *testing.T) {
+		t.Parallel()
+		var e *macaddr.EUI64
+		assert.Equal(t, constant.NilStr, e.String())
+		assert.Equal(t, constant.NilStr, e.ByteString())
+		assert.Equal(t, constant.NilStr, e.OUI())
+		assert.Nil(t, e.Clone())
+		assert.Nil(t, e.Next())
+		assert.Nil(t, e.Previous())
+	})
+	t.Run("EUI64.ByteString()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "{2,0,94,16,0,0,0,1}", e.ByteString())
+	})
+	t.Run("EUI64.Mask()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "02:00:5e:00:00:00:00:00", e.Mask(macaddr.EUI64MaskFromPrefixLen(24)).String())
+		assert.Nil(t, e.Mask(&macaddr.EUI64{}))
+	})
+	t.Run("EUI64.Next()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, macaddr.MustParseEUI64("02:00:5e:10:00:00:00:02"), e.Next())
+		e := macaddr.MustParseEUI64("02:00:5e:10:00:00:00:ff")
+		assert.Equal(t, macaddr.MustParseEUI64("02:00:5e:10:00:00:01:00"), e.Next())
+		e = macaddr.MustParseEUI64("ff:ff:ff:ff:ff:ff:ff:ff")
+		assert.Equal(t, e, e.Next())
+	})
+	t.Run("EUI64.Previous()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, macaddr.MustParseEUI64("02:00:5e:10:00:00:00:00"), e.Previous())
+		e := macaddr.MustParseEUI64("00:00:00:00:00:00:00:00")
+		assert.Equal(t, e, e.Previous())
+	})
+	t.Run("EUI64 comparison", func(t *testing.T) {
+		t.Parallel()
+		lo := macaddr.MustParseEUI64("02:00:5e:10:00:00:00:00")
+		hi := macaddr.MustParseEUI64("f2:00:5e:10:00:00:00:00")
+		assert.True(t, e.Equal(macaddr.MustParseEUI64(s)))
+		assert.False(t, e.Equal(nil))
+		assert.True(t, hi.GreaterThan(e))
+		assert.True(t, lo.LessThan(e))
+		assert.False(t, e.LessThan(nil))
+		assert.True(t, e.GEqual(e))
+		assert.True(t, e.LEqual(hi))
+		assert.False(t, e.GEqual(hi))
+	})
+	t.Run("EUI64.OUI()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "02:00:5e", e.OUI())
+		assert.Equal(t, "02:00:5e:10:00:00:00:00/36", e.OUI(36))
+	})
+}
+
+func Test_EUI64MaskFromPrefixLen(t *testing.T) {
+	for i := 0; i <= constant.EUI64BitLen; i++ {
+		t.Run(fmt.Sprintf("len %d", i), func(t *testing.T) {
+			t.Parallel()
+			r := macaddr.EUI64MaskFromPrefixLen(i)
+			assert.Equal(t, i, read.PrefixLength(*r))
+		})
+	}
+	assert.Equal(t, "ff:ff:ff:ff:ff:ff:ff:ff", macaddr.EUI64MaskFromPrefixLen(1000).String())
+}
+
+func ExampleParseEUI64() {
+	eui, err := macaddr.ParseEUI64("02:00:5e:10:00:00:00:01")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(eui.String())
+	fmt.Println(eui.Dots())
+	// Output:
+	// 02:00:5e:10:00:00:00:01
+	// 0200.5e10.0000.0001
+}
+
+func ExampleEUI64_OUI() {
+	eui := macaddr.MustParseEUI64("02:00:5e:10:00:00:00:01")
+	fmt.Println(eui.OUI())
+	// Output:
+	// 02:00:5e
+}
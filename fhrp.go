@@ -0,0 +1,180 @@
+package macaddr
+
+import (
+	"fmt"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// FHRPProtocol is a first-hop redundancy protocol that uses a virtual MAC address.
+type FHRPProtocol int
+
+const (
+	// FHRPUnknown is an unknown protocol.
+	FHRPUnknown FHRPProtocol = iota
+	// FHRPVRRP is the Virtual Router Redundancy Protocol, versions 2 and 3 (RFC 5798). IPv4
+	// groups use 00:00:5e:00:01:XX and IPv6 groups use 00:00:5e:00:02:XX.
+	FHRPVRRP
+	// FHRPHSRPv1 is Cisco's Hot Standby Router Protocol version 1, using 00:00:0c:07:ac:XX.
+	FHRPHSRPv1
+	// FHRPHSRPv2 is Cisco's Hot Standby Router Protocol version 2. IPv4 groups use
+	// 00:00:0c:9f:fX:XX and IPv6 groups use 00:05:73:a0:0X:XX.
+	FHRPHSRPv2
+	// FHRPGLBP is Cisco's Gateway Load Balancing Protocol, using 00:07:b4:0X:XX:YY, where XXX is a
+	// 10-bit group number and YY is the forwarder number.
+	FHRPGLBP
+	// FHRPCARP is the Common Address Redundancy Protocol. CARP uses the same virtual MAC address as
+	// IPv4 VRRP, 00:00:5e:00:01:XX, for both address families, so CARP addresses decode as VRRP.
+	FHRPCARP
+)
+
+// String returns the name of the FHRPProtocol, e.g. 'HSRPv2'.
+func (p FHRPProtocol) String() string {
+	switch p {
+	case FHRPVRRP:
+		return "VRRP"
+	case FHRPHSRPv1:
+		return "HSRPv1"
+	case FHRPHSRPv2:
+		return "HSRPv2"
+	case FHRPGLBP:
+		return "GLBP"
+	case FHRPCARP:
+		return "CARP"
+	}
+	return "Unknown"
+}
+
+// IPFamily is an IP address family.
+type IPFamily int
+
+const (
+	// FamilyUnspecified is used when the address family cannot be determined, e.g. a GLBP or CARP
+	// virtual MAC address, which is the same for both families.
+	FamilyUnspecified IPFamily = iota
+	// FamilyIPv4 is IPv4.
+	FamilyIPv4
+	// FamilyIPv6 is IPv6.
+	FamilyIPv6
+)
+
+// String returns the name of the IPFamily, e.g. 'IPv4'.
+func (f IPFamily) String() string {
+	switch f {
+	case FamilyIPv4:
+		return "IPv4"
+	case FamilyIPv6:
+		return "IPv6"
+	}
+	return "Unspecified"
+}
+
+// FHRP is a first-hop redundancy protocol group, identified by its virtual MAC address.
+type FHRP struct {
+	// Protocol is the first-hop redundancy protocol.
+	Protocol FHRPProtocol
+	// Family is the address family of the group. VRRP and HSRPv2 use different virtual MAC
+	// addresses for IPv4 and IPv6 groups, HSRPv1 supports only IPv4, and GLBP and CARP use
+	// FamilyUnspecified.
+	Family IPFamily
+	// Group is the group number, or the virtual router ID (VRID) for VRRP and CARP.
+	Group int
+	// Forwarder is the GLBP forwarder number, from 1 to 4. It is unused by other protocols.
+	Forwarder int
+}
+
+// String returns a human-readable representation of the FHRP group, e.g. 'VRRP IPv4 group 1'.
+func (f FHRP) String() string {
+	s := f.Protocol.String()
+	if f.Family != FamilyUnspecified {
+		s += " " + f.Family.String()
+	}
+	s += fmt.Sprintf(" group %d", f.Group)
+	if f.Protocol == FHRPGLBP {
+		s += fmt.Sprintf(" forwarder %d", f.Forwarder)
+	}
+	return s
+}
+
+// MAC returns the virtual MAC address of the FHRP group. An error is returned if the group
+// number, forwarder or address family is invalid for the protocol.
+func (f FHRP) MAC() (*MACAddress, error) {
+	switch f.Protocol {
+	case FHRPVRRP:
+		if f.Group < 1 || f.Group > 255 {
+			return nil, f.groupError()
+		}
+		switch f.Family {
+		case FamilyIPv4:
+			return FromBytes(0x00, 0x00, 0x5e, 0x00, 0x01, byte(f.Group)), nil
+		case FamilyIPv6:
+			return FromBytes(0x00, 0x00, 0x5e, 0x00, 0x02, byte(f.Group)), nil
+		}
+	case FHRPCARP:
+		if f.Group < 1 || f.Group > 255 {
+			return nil, f.groupError()
+		}
+		return FromBytes(0x00, 0x00, 0x5e, 0x00, 0x01, byte(f.Group)), nil
+	case FHRPHSRPv1:
+		if f.Group < 0 || f.Group > 255 {
+			return nil, f.groupError()
+		}
+		if f.Family == FamilyIPv4 || f.Family == FamilyUnspecified {
+			return FromBytes(0x00, 0x00, 0x0c, 0x07, 0xac, byte(f.Group)), nil
+		}
+	case FHRPHSRPv2:
+		if f.Group < 0 || f.Group > 4095 {
+			return nil, f.groupError()
+		}
+		switch f.Family {
+		case FamilyIPv4:
+			return FromBytes(0x00, 0x00, 0x0c, 0x9f, 0xf0|byte(f.Group>>8), byte(f.Group)), nil
+		case FamilyIPv6:
+			return FromBytes(0x00, 0x05, 0x73, 0xa0, byte(f.Group>>8), byte(f.Group)), nil
+		}
+	case FHRPGLBP:
+		if f.Group < 0 || f.Group > 1023 {
+			return nil, f.groupError()
+		}
+		if f.Forwarder < 1 || f.Forwarder > 4 {
+			return nil, fmt.Errorf("'%d' is an invalid GLBP forwarder", f.Forwarder)
+		}
+		return FromBytes(0x00, 0x07, 0xb4, byte(f.Group>>8), byte(f.Group), byte(f.Forwarder)), nil
+	default:
+		return nil, fmt.Errorf("'%d' is an invalid first-hop redundancy protocol", f.Protocol)
+	}
+	return nil, fmt.Errorf("%s is not supported by %s", f.Family, f.Protocol)
+}
+
+// groupError creates an error for an out of range group number.
+func (f FHRP) groupError() error {
+	return fmt.Errorf("'%d' is an invalid %s group", f.Group, f.Protocol)
+}
+
+// FHRP decodes the first-hop redundancy protocol group of a virtual MAC address. If the
+// MACAddress is not a known virtual MAC address, FHRP returns false. CARP virtual MAC addresses
+// are indistinguishable from IPv4 VRRP, and are decoded as VRRP.
+func (m *MACAddress) FHRP() (FHRP, bool) {
+	if m == nil || len(*m) != constant.MacByteLen {
+		return FHRP{}, false
+	}
+	b := *m
+	switch {
+	case b[0] == 0x00 && b[1] == 0x00 && b[2] == 0x5e && b[3] == 0x00 && b[5] != 0:
+		switch b[4] {
+		case 0x01:
+			return FHRP{Protocol: FHRPVRRP, Family: FamilyIPv4, Group: int(b[5])}, true
+		case 0x02:
+			return FHRP{Protocol: FHRPVRRP, Family: FamilyIPv6, Group: int(b[5])}, true
+		}
+	case b[0] == 0x00 && b[1] == 0x00 && b[2] == 0x0c && b[3] == 0x07 && b[4] == 0xac:
+		return FHRP{Protocol: FHRPHSRPv1, Family: FamilyIPv4, Group: int(b[5])}, true
+	case b[0] == 0x00 && b[1] == 0x00 && b[2] == 0x0c && b[3] == 0x9f && b[4]&0xf0 == 0xf0:
+		return FHRP{Protocol: FHRPHSRPv2, Family: FamilyIPv4, Group: int(b[4]&0x0f)<<8 | int(b[5])}, true
+	case b[0] == 0x00 && b[1] == 0x05 && b[2] == 0x73 && b[3] == 0xa0 && b[4]&0xf0 == 0x00:
+		return FHRP{Protocol: FHRPHSRPv2, Family: FamilyIPv6, Group: int(b[4])<<8 | int(b[5])}, true
+	case b[0] == 0x00 && b[1] == 0x07 && b[2] == 0xb4 && b[3]&0xfc == 0x00 && b[5] >= 1 && b[5] <= 4:
+		return FHRP{Protocol: FHRPGLBP, Group: int(b[3])<<8 | int(b[4]), Forwarder: int(b[5])}, true
+	}
+	return FHRP{}, false
+}
@@ -0,0 +1,85 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_FHRP(t *testing.T) {
+	type pair struct {
+		fhrp macaddr.FHRP
+		mac  string
+	}
+	tests := []pair{
+		{macaddr.FHRP{Protocol: macaddr.FHRPVRRP, Family: macaddr.FamilyIPv4, Group: 1}, "00:00:5e:00:01:01"},
+		{macaddr.FHRP{Protocol: macaddr.FHRPVRRP, Family: macaddr.FamilyIPv6, Group: 255}, "00:00:5e:00:02:ff"},
+		{macaddr.FHRP{Protocol: macaddr.FHRPHSRPv1, Family: macaddr.FamilyIPv4, Group: 10}, "00:00:0c:07:ac:0a"},
+		{macaddr.FHRP{Protocol: macaddr.FHRPHSRPv2, Family: macaddr.FamilyIPv4, Group: 4095}, "00:00:0c:9f:ff:ff"},
+		{macaddr.FHRP{Protocol: macaddr.FHRPHSRPv2, Family: macaddr.FamilyIPv6, Group: 256}, "00:05:73:a0:01:00"},
+		{macaddr.FHRP{Protocol: macaddr.FHRPGLBP, Group: 1, Forwarder: 2}, "00:07:b4:00:01:02"},
+		{macaddr.FHRP{Protocol: macaddr.FHRPGLBP, Group: 1023, Forwarder: 4}, "00:07:b4:03:ff:04"},
+	}
+	for _, p := range tests {
+		p := p
+		t.Run(p.fhrp.String(), func(t *testing.T) {
+			t.Parallel()
+			mac, err := p.fhrp.MAC()
+			require.NoError(t, err)
+			assert.Equal(t, p.mac, mac.String())
+			f, ok := mac.FHRP()
+			assert.True(t, ok)
+			assert.Equal(t, p.fhrp, f)
+		})
+	}
+	t.Run("CARP", func(t *testing.T) {
+		t.Parallel()
+		mac, err := macaddr.FHRP{Protocol: macaddr.FHRPCARP, Group: 1}.MAC()
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:01:01", mac.String())
+		f, ok := mac.FHRP()
+		assert.True(t, ok)
+		assert.Equal(t, macaddr.FHRPVRRP, f.Protocol)
+	})
+	t.Run("MAC() errors", func(t *testing.T) {
+		t.Parallel()
+		for _, f := range []macaddr.FHRP{
+			{Protocol: macaddr.FHRPVRRP, Family: macaddr.FamilyIPv4, Group: 0},
+			{Protocol: macaddr.FHRPVRRP, Family: macaddr.FamilyIPv4, Group: 256},
+			{Protocol: macaddr.FHRPVRRP, Group: 1},
+			{Protocol: macaddr.FHRPCARP, Group: 0},
+			{Protocol: macaddr.FHRPHSRPv1, Family: macaddr.FamilyIPv6, Group: 1},
+			{Protocol: macaddr.FHRPHSRPv1, Group: 256},
+			{Protocol: macaddr.FHRPHSRPv2, Family: macaddr.FamilyIPv4, Group: 4096},
+			{Protocol: macaddr.FHRPHSRPv2, Group: 1},
+			{Protocol: macaddr.FHRPGLBP, Group: 1024, Forwarder: 1},
+			{Protocol: macaddr.FHRPGLBP, Group: 1, Forwarder: 5},
+			{Protocol: macaddr.FHRPUnknown, Group: 1},
+		} {
+			_, err := f.MAC()
+			assert.Error(t, err, f.String())
+		}
+	})
+	t.Run("FHRP() not virtual", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range []string{"00:00:5e:00:53:ab", "00:00:5e:00:01:00", "00:05:73:a0:10:00", "00:07:b4:04:00:01", "00:07:b4:00:01:05"} {
+			_, ok := macaddr.MustParseMACAddress(s).FHRP()
+			assert.False(t, ok, s)
+		}
+		var m *macaddr.MACAddress
+		_, ok := m.FHRP()
+		assert.False(t, ok)
+	})
+}
+
+func ExampleMACAddress_FHRP() {
+	f, ok := macaddr.MustParseMACAddress("00:00:0c:9f:f0:0a").FHRP()
+	fmt.Println(ok)
+	fmt.Println(f.String())
+	// Output:
+	// true
+	// HSRPv2 IPv4 group 10
+}
@@ -0,0 +1,156 @@
+package macaddr
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// ErrExhausted is returned when every address satisfying a Generator's constraints is excluded.
+var ErrExhausted = errors.New("no addresses available")
+
+const (
+	// generateAttempts is the number of random addresses a Generator tries before searching the
+	// remaining addresses exhaustively.
+	generateAttempts = 64
+	// generateSearchBits is the largest number of free bits a Generator searches exhaustively.
+	generateSearchBits = 20
+	// firstOctetShift is the position of the first octet of an address as an integer.
+	firstOctetShift = constant.MacBitLen - 8
+)
+
+// Generator generates random MAC addresses satisfying a set of constraints. The zero value
+// generates locally administered unicast addresses using crypto/rand.
+type Generator struct {
+	// Rand is the source of random bytes. If nil, crypto/rand is used. Use a seeded source, e.g.
+	// math/rand, for reproducible output.
+	Rand io.Reader
+	// Multicast generates group addresses, with the individual/group (I/G) bit set. By default,
+	// unicast addresses are generated.
+	Multicast bool
+	// Universal generates universally administered addresses, with the universal/local (U/L) bit
+	// cleared. By default, locally administered addresses are generated.
+	Universal bool
+	// Prefix restricts generated addresses to a MACPrefix. If the prefix length covers the I/G
+	// or U/L bits, they must agree with Multicast and Universal.
+	Prefix *MACPrefix
+	// Quadrant restricts generated addresses to an IEEE 802c SLAP quadrant. It requires locally
+	// administered addresses. SLAPNone applies no restriction.
+	Quadrant SLAPQuadrant
+
+	exclude map[Addr]struct{}
+}
+
+// Exclude prevents the Generator from generating any of addrs, e.g. addresses already in use.
+func (g *Generator) Exclude(addrs ...Addr) {
+	if g.exclude == nil {
+		g.exclude = make(map[Addr]struct{}, len(addrs))
+	}
+	for _, a := range addrs {
+		if a.IsValid() {
+			g.exclude[a] = struct{}{}
+		}
+	}
+}
+
+// constraints returns the bits fixed by the Generator's constraints, and their values.
+func (g *Generator) constraints() (value, mask uint64, err error) {
+	if g.Prefix != nil {
+		value, mask = g.Prefix.span()
+	}
+	fix := func(bit byte, set bool, name string) error {
+		b := uint64(bit) << firstOctetShift
+		v := uint64(0)
+		if set {
+			v = b
+		}
+		if mask&b != 0 && value&b != v {
+			return fmt.Errorf("%s conflicts with prefix %s", name, g.Prefix.String())
+		}
+		value, mask = value&^b|v, mask|b
+		return nil
+	}
+	if err = fix(constant.GroupBit, g.Multicast, "multicast constraint"); err != nil {
+		return 0, 0, err
+	}
+	if err = fix(constant.LocalBit, !g.Universal, "universal constraint"); err != nil {
+		return 0, 0, err
+	}
+	if g.Quadrant != SLAPNone {
+		q, ok := slapBits[g.Quadrant]
+		if !ok || g.Universal {
+			return 0, 0, fmt.Errorf("SLAP quadrant %s requires locally administered addresses", g.Quadrant)
+		}
+		for _, bit := range []byte{0x04, 0x08} {
+			if err = fix(bit, q&bit != 0, fmt.Sprintf("SLAP quadrant %s", g.Quadrant)); err != nil {
+				return 0, 0, err
+			}
+		}
+	}
+	return value, mask, nil
+}
+
+// random reads a random 48-bit integer.
+func (g *Generator) random() (uint64, error) {
+	r := g.Rand
+	if r == nil {
+		r = rand.Reader
+	}
+	var b [8]byte
+	if _, err := io.ReadFull(r, b[2:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint64(b[:]), nil
+}
+
+// GenerateAddr generates a random Addr satisfying the Generator's constraints. An error matching
+// ErrExhausted is returned if every satisfying address is excluded.
+func (g *Generator) GenerateAddr() (Addr, error) {
+	value, mask, err := g.constraints()
+	if err != nil {
+		return Addr{}, err
+	}
+	free := addrBits &^ mask
+	for i := 0; i < generateAttempts; i++ {
+		r, err := g.random()
+		if err != nil {
+			return Addr{}, err
+		}
+		a := AddrFromUint64(value | r&free)
+		if _, ok := g.exclude[a]; !ok {
+			return a, nil
+		}
+	}
+	if bits.OnesCount64(free) <= generateSearchBits {
+		// Search every satisfying address, starting from a random one, by enumerating the subsets
+		// of the free bits.
+		r, err := g.random()
+		if err != nil {
+			return Addr{}, err
+		}
+		s := r & free
+		for n := uint64(1) << bits.OnesCount64(free); n > 0; n-- {
+			a := AddrFromUint64(value | s)
+			if _, ok := g.exclude[a]; !ok {
+				return a, nil
+			}
+			s = (s - free) & free
+		}
+	}
+	return Addr{}, fmt.Errorf("failed to generate an address after %d attempts: %w", generateAttempts, ErrExhausted)
+}
+
+// Generate generates a random MACAddress satisfying the Generator's constraints. An error matching
+// ErrExhausted is returned if every satisfying address is excluded.
+func (g *Generator) Generate() (*MACAddress, error) {
+	a, err := g.GenerateAddr()
+	if err != nil {
+		return nil, err
+	}
+	return a.MACAddress(), nil
+}
@@ -0,0 +1,130 @@
+package macaddr_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_Generator(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+		var g macaddr.Generator
+		for i := 0; i < 100; i++ {
+			mac, err := g.Generate()
+			require.NoError(t, err)
+			assert.True(t, mac.IsLocal(), mac.String())
+			assert.True(t, mac.IsUnicast(), mac.String())
+		}
+	})
+	t.Run("reproducible", func(t *testing.T) {
+		t.Parallel()
+		a := macaddr.Generator{Rand: rand.New(rand.NewSource(1))}
+		b := macaddr.Generator{Rand: rand.New(rand.NewSource(1))}
+		for i := 0; i < 10; i++ {
+			x, err := a.GenerateAddr()
+			require.NoError(t, err)
+			y, err := b.GenerateAddr()
+			require.NoError(t, err)
+			assert.Equal(t, x, y)
+		}
+	})
+	t.Run("multicast universal", func(t *testing.T) {
+		t.Parallel()
+		g := macaddr.Generator{Rand: bytes.NewReader(bytes.Repeat([]byte{0xfe}, 6)), Multicast: true, Universal: true}
+		mac, err := g.Generate()
+		require.NoError(t, err)
+		assert.Equal(t, "fd:fe:fe:fe:fe:fe", mac.String())
+	})
+	t.Run("prefix", func(t *testing.T) {
+		t.Parallel()
+		_, p := macaddr.MustParseMACPrefix("02:00:5e:10:00:00/36")
+		g := macaddr.Generator{Rand: rand.New(rand.NewSource(1)), Prefix: p}
+		for i := 0; i < 100; i++ {
+			mac, err := g.Generate()
+			require.NoError(t, err)
+			assert.True(t, p.Contains(mac), mac.String())
+		}
+	})
+	t.Run("short prefix", func(t *testing.T) {
+		t.Parallel()
+		_, p := macaddr.MustParseMACPrefix("f0:00:00:00:00:00/4")
+		g := macaddr.Generator{Rand: rand.New(rand.NewSource(1)), Prefix: p, Quadrant: macaddr.SLAPELI}
+		for i := 0; i < 100; i++ {
+			mac, err := g.Generate()
+			require.NoError(t, err)
+			assert.True(t, p.Contains(mac), mac.String())
+			assert.Equal(t, macaddr.SLAPELI, mac.SLAPQuadrant(), mac.String())
+			assert.True(t, mac.IsUnicast(), mac.String())
+		}
+	})
+	t.Run("quadrant", func(t *testing.T) {
+		t.Parallel()
+		for _, q := range []macaddr.SLAPQuadrant{macaddr.SLAPAAI, macaddr.SLAPELI, macaddr.SLAPSAI, macaddr.SLAPReserved} {
+			g := macaddr.Generator{Rand: rand.New(rand.NewSource(1)), Quadrant: q}
+			for i := 0; i < 20; i++ {
+				mac, err := g.Generate()
+				require.NoError(t, err)
+				assert.Equal(t, q, mac.SLAPQuadrant(), mac.String())
+			}
+		}
+	})
+	t.Run("conflicts", func(t *testing.T) {
+		t.Parallel()
+		_, universal := macaddr.MustParseMACPrefix("00:00:5e:00:00:00/24")
+		_, multicast := macaddr.MustParseMACPrefix("03:00:5e:00:00:00/24")
+		_, aai := macaddr.MustParseMACPrefix("02:00:00:00:00:00/8")
+		for _, g := range []macaddr.Generator{
+			{Prefix: universal},
+			{Prefix: multicast},
+			{Prefix: universal, Universal: true, Multicast: true},
+			{Universal: true, Quadrant: macaddr.SLAPAAI},
+			{Quadrant: macaddr.SLAPQuadrant(99)},
+			{Prefix: aai, Quadrant: macaddr.SLAPELI},
+		} {
+			g := g
+			_, err := g.Generate()
+			assert.Error(t, err)
+		}
+	})
+	t.Run("Exclude()", func(t *testing.T) {
+		t.Parallel()
+		_, p := macaddr.MustParseMACPrefix("02:00:5e:00:53:00/44")
+		g := macaddr.Generator{Rand: rand.New(rand.NewSource(1)), Prefix: p}
+		seen := map[macaddr.Addr]struct{}{}
+		for i := 0; i < 16; i++ {
+			a, err := g.GenerateAddr()
+			require.NoError(t, err)
+			assert.NotContains(t, seen, a)
+			seen[a] = struct{}{}
+			g.Exclude(a)
+		}
+		_, err := g.GenerateAddr()
+		assert.True(t, errors.Is(err, macaddr.ErrExhausted))
+	})
+	t.Run("Rand error", func(t *testing.T) {
+		t.Parallel()
+		g := macaddr.Generator{Rand: bytes.NewReader(nil)}
+		_, err := g.Generate()
+		assert.Error(t, err)
+	})
+}
+
+func ExampleGenerator() {
+	_, p := macaddr.MustParseMACPrefix("02:00:5e:10:00:00/44")
+	g := macaddr.Generator{Rand: bytes.NewReader([]byte{0, 0, 0, 0, 0, 0x05}), Prefix: p}
+	g.Exclude(macaddr.MustParseAddr("02:00:5e:10:00:01"))
+	mac, err := g.Generate()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(mac.String())
+	// Output:
+	// 02:00:5e:10:00:05
+}
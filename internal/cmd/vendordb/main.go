@@ -0,0 +1,89 @@
+// Command vendordb builds the embedded vendor snapshot from IEEE registry CSV exports.
+//
+// Download oui.csv, mam.csv, oui36.csv, cid.csv and iab.csv from https://standards-oui.ieee.org
+// into internal/vendordb/ieee, then run go generate ./internal/vendordb. An assignment that
+// appears in more than one file is an error, so a stale or hand-written file cannot silently
+// replace the official names.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.mdl.wtf/go-macaddr/internal/vendordb"
+	"go.mdl.wtf/go-macaddr/registry"
+)
+
+func main() {
+	dir := flag.String("dir", "ieee", "directory containing IEEE registry CSV exports")
+	out := flag.String("o", "vendors.db", "output file")
+	flag.Parse()
+
+	if err := run(*dir, *out); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// exports is the file name of each IEEE registry export the snapshot should be generated from.
+var exports = []string{"oui.csv", "mam.csv", "oui36.csv", "cid.csv", "iab.csv"}
+
+func run(dir, out string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.csv"))
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no CSV files found in '%s'", dir)
+	}
+	for _, e := range exports {
+		if _, err := os.Stat(filepath.Join(dir, e)); err != nil {
+			fmt.Fprintf(os.Stderr, "warning: %s not found in '%s', the snapshot will not include its assignments\n", e, dir)
+		}
+	}
+	reg := registry.New()
+	seen := map[string]string{}
+	for _, f := range files {
+		entries, err := registry.ParseFile(f)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f, err)
+		}
+		// The IEEE lists some assignments more than once within a single export, so duplicates
+		// are only rejected across files.
+		local := map[string]struct{}{}
+		for _, e := range entries {
+			k := e.Prefix.String()
+			if prev, ok := seen[k]; ok {
+				return fmt.Errorf("%s: assignment %s is also in %s", f, k, prev)
+			}
+			local[k] = struct{}{}
+		}
+		for k := range local {
+			seen[k] = f
+		}
+		reg.Add(entries...)
+	}
+	var records []vendordb.Record
+	for _, e := range reg.Entries() {
+		records = append(records, vendordb.Record{
+			Value: uint64(e.Prefix.MAC.Int()),
+			Len:   e.Prefix.PrefixLen(),
+			Org:   e.Organization,
+		})
+	}
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	if err := vendordb.Encode(f, records); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	fmt.Printf("wrote %d records to %s\n", len(records), out)
+	return nil
+}
@@ -8,12 +8,30 @@ const (
 	NilStr   string = "<nil>"
 )
 
+const (
+	Fmt64Dash  string = "xx-xx-xx-xx-xx-xx-xx-xx"
+	Fmt64Dot   string = "xxxx.xxxx.xxxx.xxxx"
+	Fmt64Colon string = "xx:xx:xx:xx:xx:xx:xx:xx"
+	Fmt64None  string = "xxxxxxxxxxxxxxxx"
+)
+
 const (
 	Big                 int = 0xFFFFFF
 	HexStrLen           int = 12
 	HexStrWithColonsLen int = 17
 	MacBitLen           int = 48
 	MacByteLen          int = 6
+	EUI64HexStrLen      int = 16
+	EUI64BitLen         int = 64
+	EUI64ByteLen        int = 8
+	IPoIBByteLen        int = 20
 )
 
 var HexDigits = []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9", "a", "b", "c", "d", "e", "f"}
+
+const (
+	// GroupBit is the individual/group (I/G) bit of the first octet.
+	GroupBit byte = 0x01
+	// LocalBit is the universal/local (U/L) bit of the first octet.
+	LocalBit byte = 0x02
+)
@@ -12,6 +12,16 @@ func ByteArrayToInt64(arr []byte) int64 {
 	return res
 }
 
+// ByteArrayToUint64 converts a byte array to a uint64.
+func ByteArrayToUint64(arr []byte) uint64 {
+	var res uint64
+	for _, v := range arr {
+		res <<= 8
+		res |= uint64(v)
+	}
+	return res
+}
+
 // ChunkStr chunks a string into chunks of n size. For example, "0123456789ab" with a size of 2
 // would become [01 23 45 67 89 ab].
 func ChunkStr(str string, size int) []string {
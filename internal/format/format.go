@@ -66,6 +66,31 @@ func PadMAC(i string) string {
 	return strings.ToLower(PadRight(r, "0", constant.HexStrLen))
 }
 
+// Template formats the lowest n hex digits of v according to a string template, where n is the
+// number of alphanumeric characters in the template. For example, a value of 0x5e0053ab and a
+// template of xxxx.xxxx would return 5e00.53ab.
+func Template(v uint64, f string) string {
+	var p []string
+	fmtStr := CreateFmtString(ReverseString(f))
+	for _, ch := range fmtStr {
+		if ch == 'x' {
+			p = append(p, constant.HexDigits[v&0xf])
+			v >>= 4
+		} else {
+			p = append(p, string(ch))
+		}
+	}
+	return ReverseString(strings.Join(p, ""))
+}
+
+// PadEUI64 right-pads an input string with zeros to guarantee the string length is 16. For
+// example, 0123456789ab becomes 0123456789ab0000.
+func PadEUI64(i string) string {
+	p := regexp.MustCompile(`[^0-9a-fA-F]+`)
+	r := p.ReplaceAllString(i, "")
+	return strings.ToLower(PadRight(r, "0", constant.EUI64HexStrLen))
+}
+
 // WithColons chunks an input string into n parts of 2 characters, and joins them with colons. For
 // example, 0123456789ab becomes 01:23:45:67:89:ab.
 func WithColons(i string) string {
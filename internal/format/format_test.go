@@ -90,3 +90,17 @@ func Test_WithColons(t *testing.T) {
 	result := format.WithColons("0123456789ab")
 	assert.Equal(t, "01:23:45:67:89:ab", result)
 }
+
+func Test_Template(t *testing.T) {
+	t.Run("works", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "5e00.53ab", format.Template(0x5e0053ab, "xxxx.xxxx"))
+	})
+}
+
+func Test_PadEUI64(t *testing.T) {
+	t.Run("works", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "0123456789ab0000", format.PadEUI64("01:23:45:67:89:ab"))
+	})
+}
@@ -1,30 +1,78 @@
 package validate
 
 import (
+	"errors"
 	"fmt"
-	"regexp"
 	"strconv"
 	"strings"
 
 	"go.mdl.wtf/go-macaddr/internal/constant"
 )
 
-// Hex ensures all alphanumeric characters in a string are valid hexadecimal characters.
-// For example, "abcdef" would return true, but "abcdefg" would return false.
+// Hex ensures all characters in a string are valid hexadecimal characters or the separators ':',
+// '-' and '.'. For example, "abcdef" would return true, but "abcdefg" would return false.
 func Hex(i string) (o bool) {
-	p := regexp.MustCompile(`[a-zA-Z0-9]+`)
-	hp := regexp.MustCompile(`[a-f0-9]+`)
-	ss := p.FindAllString(i, -1)
-	sj := strings.ToLower(strings.Join(ss, ""))
-	hps := strings.Join(hp.FindAllString(sj, -1), "")
-	return len(sj) == len(hps)
+	return FirstNonHex(i) < 0
 }
 
+// FirstNonHex returns the byte offset of the first character in a string that is neither a valid
+// hexadecimal character nor one of the separators ':', '-' and '.', or -1 if there is none. For
+// example, "0123.4567.89az" would return 13, and "00:00:5e:00:53:a#" would return 16.
+func FirstNonHex(i string) int {
+	for o := 0; o < len(i); o++ {
+		switch c := i[o]; {
+		case '0' <= c && c <= '9', 'a' <= c && c <= 'f', 'A' <= c && c <= 'F':
+		case c == ':', c == '-', c == '.':
+		default:
+			return o
+		}
+	}
+	return -1
+}
+
+// FirstNonHexPrefix operates similarly to FirstNonHex for a MAC address with an optional prefix
+// length, e.g. "00:00:5e:00:00:00/24". After the first '/', any character that is neither a valid
+// hexadecimal character nor '/' is reported; other malformed prefix lengths are left to
+// ParseMacAddrWithPrefixLen.
+func FirstNonHexPrefix(i string) int {
+	a, l, ok := strings.Cut(i, "/")
+	if o := FirstNonHex(a); o >= 0 || !ok {
+		return o
+	}
+	for o := 0; o < len(l); o++ {
+		switch c := l[o]; {
+		case '0' <= c && c <= '9', 'a' <= c && c <= 'f', 'A' <= c && c <= 'F', c == '/':
+		default:
+			return len(a) + 1 + o
+		}
+	}
+	return -1
+}
+
+// BareHex determines if a string consists only of hexadecimal characters, with no separators.
+// For example, "00005e0053ab" would return true, but "00:00:5e:00:53:ab" would return false.
+func BareHex(i string) bool {
+	if i == "" {
+		return false
+	}
+	for _, c := range i {
+		if !('0' <= c && c <= '9') && !('a' <= c && c <= 'f') && !('A' <= c && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// ErrPrefixLen is returned by ParseMacAddrWithPrefixLen when a prefix length is present but is not
+// a decimal number.
+var ErrPrefixLen = errors.New("invalid prefix length")
+
 // ParseMacAddrWithPrefixLen operates similarly to ParseMACPrefix, however, it returns the
 // validated MAC address object and the prefix length as an integer. If no prefix is provided,
-// a /48 prefix length is assumed.
+// a /48 prefix length is assumed. If a '/' is present but is not followed by only decimal digits,
+// ErrPrefixLen is returned.
 func ParseMacAddrWithPrefixLen(s string) (string, int, error) {
-	if !Hex(s) {
+	if a, _, _ := strings.Cut(s, "/"); !Hex(a) {
 		err := fmt.Errorf("'%v' is an invalid MAC address or prefix", s)
 		return "", 0, err
 	}
@@ -34,9 +82,14 @@ func ParseMacAddrWithPrefixLen(s string) (string, int, error) {
 		i = constant.MacBitLen
 	} else {
 		aa, ii := s[:i], s[i+1:]
+		for _, c := range ii {
+			if c < '0' || c > '9' {
+				return "", 0, ErrPrefixLen
+			}
+		}
 		iii, err := strconv.Atoi(ii)
 		if err != nil {
-			iii = constant.MacBitLen
+			return "", 0, ErrPrefixLen
 		}
 		a = aa
 		i = iii
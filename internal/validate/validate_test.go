@@ -49,10 +49,35 @@ func Test_ParseMacAddrWithPrefixLen(t *testing.T) {
 		require.NoError(t, e)
 	})
 	t.Run("parseMacAddrWithPrefixLen 5", func(t *testing.T) {
-		m, p, e := validate.ParseMacAddrWithPrefixLen("01:23:45:67:89:ab/ff")
-		ms := "01:23:45:67:89:ab"
-		assert.Equal(t, ms, m)
-		assert.Equal(t, constant.MacBitLen, p)
-		require.NoError(t, e)
+		for _, s := range []string{"01:23:45:67:89:ab/ff", "01:23:45:67:89:ab/", "01:23:45:67:89:ab/24/3", "01:23:45:67:89:ab/+24"} {
+			m, p, e := validate.ParseMacAddrWithPrefixLen(s)
+			assert.Empty(t, m, s)
+			assert.Equal(t, 0, p, s)
+			assert.ErrorIs(t, e, validate.ErrPrefixLen, s)
+		}
 	})
 }
+
+func Test_BareHex(t *testing.T) {
+	assert.True(t, validate.BareHex("00005E0053ab"))
+	assert.False(t, validate.BareHex("00:00:5e:00:53:ab"))
+	assert.False(t, validate.BareHex(""))
+}
+
+func Test_FirstNonHex(t *testing.T) {
+	assert.Equal(t, -1, validate.FirstNonHex("00:00:5E:00:53:ab"))
+	assert.Equal(t, 13, validate.FirstNonHex("0123.4567.89az"))
+	assert.Equal(t, 0, validate.FirstNonHex("G"))
+	assert.Equal(t, 16, validate.FirstNonHex("00:00:5e:00:53:a#"))
+	assert.Equal(t, 2, validate.FirstNonHex("00#00#5e#00#53#ab"))
+	assert.Equal(t, 2, validate.FirstNonHex("00 00 5e 00 53 ab"))
+	assert.Equal(t, 17, validate.FirstNonHex("00:00:5e:00:53:ab/24"))
+}
+
+func Test_FirstNonHexPrefix(t *testing.T) {
+	assert.Equal(t, -1, validate.FirstNonHexPrefix("00:00:5e:00:53:ab"))
+	assert.Equal(t, -1, validate.FirstNonHexPrefix("00:00:5e:00:53:ab/24"))
+	assert.Equal(t, -1, validate.FirstNonHexPrefix("00:00:5e:00:53:ab/24/3"))
+	assert.Equal(t, 16, validate.FirstNonHexPrefix("00:00:5e:00:53:a#/24"))
+	assert.Equal(t, 19, validate.FirstNonHexPrefix("00:00:5e:00:53:ab/2x"))
+}
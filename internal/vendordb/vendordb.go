@@ -0,0 +1,230 @@
+// Package vendordb encodes, decodes and embeds a compact snapshot of the IEEE registries, used to
+// look up the vendor of a MAC address without any setup.
+//
+// The snapshot is a gzip-compressed stream of:
+//
+//	magic "MACV", version byte
+//	uvarint name count, then each organization name as uvarint length + bytes
+//	uvarint record count, then each record, sorted by prefix value, as:
+//	  byte prefix length, uvarint delta from the previous prefix value, uvarint name index
+//
+// Names are deduplicated and prefix values are delta-encoded, so the snapshot stays small.
+package vendordb
+
+//go:generate go run ../cmd/vendordb -dir ieee -o vendors.db
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	_ "embed"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+const (
+	magic   string = "MACV"
+	version byte   = 1
+	bitLen  int    = 48
+)
+
+//go:embed vendors.db
+var snapshot []byte
+
+var (
+	defaultDB   *DB
+	defaultOnce sync.Once
+)
+
+// Record is a single registry assignment.
+type Record struct {
+	// Value is the integer value of the first address of the assignment.
+	Value uint64
+	// Len is the prefix length of the assignment.
+	Len int
+	// Org is the name of the assignee.
+	Org string
+}
+
+// table contains every assignment of a single prefix length, sorted by value.
+type table struct {
+	len    int
+	values []uint64
+	names  []uint32
+}
+
+// DB is a decoded vendor snapshot, indexed for longest-prefix lookup.
+type DB struct {
+	names []string
+	// tables is sorted by prefix length, longest first.
+	tables []*table
+}
+
+// Default returns the DB decoded from the embedded snapshot. The snapshot is only decoded once,
+// on first use.
+func Default() *DB {
+	defaultOnce.Do(func() {
+		db, err := Decode(bytes.NewReader(snapshot))
+		if err != nil {
+			panic(fmt.Errorf("embedded vendor snapshot is corrupt: %w", err))
+		}
+		defaultDB = db
+	})
+	return defaultDB
+}
+
+// Len returns the number of records in the DB.
+func (db *DB) Len() int {
+	n := 0
+	for _, t := range db.tables {
+		n += len(t.values)
+	}
+	return n
+}
+
+// Lookup returns the organization of the most specific record of prefix length maxLen or less
+// containing the address value v.
+func (db *DB) Lookup(v uint64, maxLen int) (string, bool) {
+	for _, t := range db.tables {
+		if t.len > maxLen {
+			continue
+		}
+		k := v &^ (1<<(bitLen-t.len) - 1)
+		i := sort.Search(len(t.values), func(i int) bool { return t.values[i] >= k })
+		if i < len(t.values) && t.values[i] == k {
+			return db.names[t.names[i]], true
+		}
+	}
+	return "", false
+}
+
+// Encode writes records to w in the snapshot format.
+func Encode(w io.Writer, records []Record) error {
+	sorted := make([]Record, len(records))
+	copy(sorted, records)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Value == sorted[j].Value {
+			return sorted[i].Len < sorted[j].Len
+		}
+		return sorted[i].Value < sorted[j].Value
+	})
+
+	index := make(map[string]uint64)
+	var names []string
+	for _, r := range sorted {
+		if r.Len <= 0 || r.Len > bitLen {
+			return fmt.Errorf("'%d' is an invalid prefix length", r.Len)
+		}
+		if _, ok := index[r.Org]; !ok {
+			index[r.Org] = uint64(len(names))
+			names = append(names, r.Org)
+		}
+	}
+
+	zw := gzip.NewWriter(w)
+	bw := bufio.NewWriter(zw)
+	buf := make([]byte, binary.MaxVarintLen64)
+	uvarint := func(v uint64) {
+		n := binary.PutUvarint(buf, v)
+		bw.Write(buf[:n])
+	}
+	bw.WriteString(magic)
+	bw.WriteByte(version)
+	uvarint(uint64(len(names)))
+	for _, n := range names {
+		uvarint(uint64(len(n)))
+		bw.WriteString(n)
+	}
+	uvarint(uint64(len(sorted)))
+	var prev uint64
+	for _, r := range sorted {
+		bw.WriteByte(byte(r.Len))
+		uvarint(r.Value - prev)
+		uvarint(index[r.Org])
+		prev = r.Value
+	}
+	if err := bw.Flush(); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// Decode reads a snapshot from r.
+func Decode(r io.Reader) (*DB, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	br := bufio.NewReader(zr)
+
+	hdr := make([]byte, len(magic)+1)
+	if _, err := io.ReadFull(br, hdr); err != nil {
+		return nil, err
+	}
+	if string(hdr[:len(magic)]) != magic {
+		return nil, errors.New("invalid vendor snapshot header")
+	}
+	if hdr[len(magic)] != version {
+		return nil, fmt.Errorf("unsupported vendor snapshot version %d", hdr[len(magic)])
+	}
+
+	n, err := binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	db := &DB{names: make([]string, 0, n)}
+	for i := uint64(0); i < n; i++ {
+		l, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+		b := make([]byte, l)
+		if _, err := io.ReadFull(br, b); err != nil {
+			return nil, err
+		}
+		db.names = append(db.names, string(b))
+	}
+
+	n, err = binary.ReadUvarint(br)
+	if err != nil {
+		return nil, err
+	}
+	tables := make(map[int]*table)
+	var v uint64
+	for i := uint64(0); i < n; i++ {
+		l, err := br.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if l == 0 || int(l) > bitLen {
+			return nil, fmt.Errorf("'%d' is an invalid prefix length", l)
+		}
+		d, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+		ni, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+		if ni >= uint64(len(db.names)) {
+			return nil, fmt.Errorf("name index %d out of range", ni)
+		}
+		v += d
+		t, ok := tables[int(l)]
+		if !ok {
+			t = &table{len: int(l)}
+			tables[int(l)] = t
+			db.tables = append(db.tables, t)
+		}
+		t.values = append(t.values, v)
+		t.names = append(t.names, uint32(ni))
+	}
+	sort.Slice(db.tables, func(i, j int) bool { return db.tables[i].len > db.tables[j].len })
+	return db, nil
+}
@@ -0,0 +1,86 @@
+package vendordb_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr/internal/vendordb"
+)
+
+func Test_EncodeDecode(t *testing.T) {
+	records := []vendordb.Record{
+		{Value: 0x00005e000000, Len: 24, Org: "Large"},
+		{Value: 0x00005e100000, Len: 28, Org: "Medium"},
+		{Value: 0x00005e100000, Len: 36, Org: "Small"},
+		{Value: 0x0a1234000000, Len: 24, Org: "Large"},
+	}
+	var buf bytes.Buffer
+	require.NoError(t, vendordb.Encode(&buf, records))
+	db, err := vendordb.Decode(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, 4, db.Len())
+
+	type lookup struct {
+		v   uint64
+		max int
+		org string
+	}
+	tests := []lookup{
+		{0x00005e0053ab, 48, "Large"},
+		{0x00005e1fffff, 48, "Medium"},
+		{0x00005e1000ff, 48, "Small"},
+		{0x00005e1000ff, 28, "Medium"},
+		{0x0a1234567890, 48, "Large"},
+	}
+	for _, l := range tests {
+		org, ok := db.Lookup(l.v, l.max)
+		assert.True(t, ok)
+		assert.Equal(t, l.org, org)
+	}
+	_, ok := db.Lookup(0xffffffffffff, 48)
+	assert.False(t, ok)
+	_, ok = db.Lookup(0x00005e0053ab, 16)
+	assert.False(t, ok)
+}
+
+func Test_Encode_invalid(t *testing.T) {
+	var buf bytes.Buffer
+	require.Error(t, vendordb.Encode(&buf, []vendordb.Record{{Len: 49}}))
+}
+
+func Test_Decode_invalid(t *testing.T) {
+	_, err := vendordb.Decode(bytes.NewReader([]byte("not gzip")))
+	require.Error(t, err)
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	zw.Write([]byte("NOPE\x01"))
+	zw.Close()
+	_, err = vendordb.Decode(&buf)
+	require.Error(t, err)
+
+	buf.Reset()
+	zw = gzip.NewWriter(&buf)
+	zw.Write([]byte("MACV\x02"))
+	zw.Close()
+	_, err = vendordb.Decode(&buf)
+	require.Error(t, err)
+}
+
+func Test_Default(t *testing.T) {
+	db := vendordb.Default()
+	assert.Greater(t, db.Len(), 25000)
+	assert.Same(t, db, vendordb.Default())
+	for v, org := range map[uint64]string{
+		0x3c22fb123456: "Apple, Inc.",
+		0x00000c123456: "Cisco Systems, Inc",
+		0x00005e0053ab: "ICANN, IANA Department",
+	} {
+		got, ok := db.Lookup(v, 48)
+		assert.True(t, ok)
+		assert.Equal(t, org, got)
+	}
+}
@@ -0,0 +1,78 @@
+package macaddr
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// ErrNotEUI64Derived is returned when an IPv6 interface identifier or EUI64 was not derived from a
+// MACAddress, i.e. it does not contain ff:fe in the middle of the identifier.
+var ErrNotEUI64Derived = errors.New("interface identifier was not derived from a MAC address")
+
+// LinkLocalPrefix is the IPv6 link-local prefix, fe80::/64.
+var LinkLocalPrefix = netip.MustParsePrefix("fe80::/64")
+
+// ModifiedEUI64 returns the modified EUI-64 interface identifier of a MACAddress, as described in
+// RFC 4291 Appendix A: ff:fe is inserted between the OUI and the remainder of the address, and the
+// universal/local bit is inverted. For example, 00:00:5e:00:53:ab becomes
+// 02:00:5e:ff:fe:00:53:ab.
+func (m *MACAddress) ModifiedEUI64() *EUI64 {
+	if m == nil || len(*m) != constant.MacByteLen {
+		return nil
+	}
+	b := *m
+	return EUI64FromBytes(b[0]^0x02, b[1], b[2], 0xff, 0xfe, b[3], b[4], b[5])
+}
+
+// MACAddressFromModifiedEUI64 recovers the MACAddress from which a modified EUI-64 interface
+// identifier was derived. ErrNotEUI64Derived is returned if the identifier does not contain
+// ff:fe in its fourth and fifth bytes.
+func MACAddressFromModifiedEUI64(e *EUI64) (*MACAddress, error) {
+	if e == nil || len(*e) != constant.EUI64ByteLen {
+		return nil, ErrNotEUI64Derived
+	}
+	b := *e
+	if b[3] != 0xff || b[4] != 0xfe {
+		return nil, ErrNotEUI64Derived
+	}
+	return FromBytes(b[0]^0x02, b[1], b[2], b[5], b[6], b[7]), nil
+}
+
+// IPv6 returns the stateless address autoconfiguration (SLAAC) address of a MACAddress within an
+// IPv6 prefix, using the modified EUI-64 interface identifier as the lower 64 bits. The prefix
+// must be an IPv6 prefix with a length of 64 bits or less.
+func (m *MACAddress) IPv6(p netip.Prefix) (netip.Addr, error) {
+	iid := m.ModifiedEUI64()
+	if iid == nil {
+		return netip.Addr{}, fmt.Errorf("'%s' is not a valid MAC address", m.String())
+	}
+	if !p.IsValid() || !p.Addr().Is6() || p.Addr().Is4In6() || p.Bits() > 64 {
+		return netip.Addr{}, fmt.Errorf("'%s' is not an IPv6 prefix of length 64 or less", p)
+	}
+	a := p.Masked().Addr().As16()
+	copy(a[8:], *iid)
+	return netip.AddrFrom16(a), nil
+}
+
+// LinkLocal returns the IPv6 link-local address (within fe80::/64) of a MACAddress.
+func (m *MACAddress) LinkLocal() netip.Addr {
+	a, err := m.IPv6(LinkLocalPrefix)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return a
+}
+
+// MACAddressFromIPv6 recovers the MACAddress from which an IPv6 address's interface identifier
+// was derived. ErrNotEUI64Derived is returned if the interface identifier is not a modified
+// EUI-64 built from a MAC address, e.g. if it is a privacy or stable-opaque address.
+func MACAddressFromIPv6(a netip.Addr) (*MACAddress, error) {
+	if !a.Is6() || a.Is4In6() {
+		return nil, fmt.Errorf("'%s' is not an IPv6 address", a)
+	}
+	b := a.As16()
+	return MACAddressFromModifiedEUI64(EUI64FromByteArray(b[8:]))
+}
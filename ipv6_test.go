@@ -0,0 +1,119 @@
+package macaddr_test
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_ModifiedEUI64(t *testing.T) {
+	t.Run("MACAddress.ModifiedEUI64()", func(t *testing.T) {
+		t.Parallel()
+		m := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+		assert.Equal(t, "02:00:5e:ff:fe:00:53:ab", m.ModifiedEUI64().String())
+		m = macaddr.MustParseMACAddress("02:00:5e:00:53:ab")
+		assert.Equal(t, "00:00:5e:ff:fe:00:53:ab", m.ModifiedEUI64().String())
+	})
+	t.Run("MACAddress.ModifiedEUI64() nil", func(t *testing.T) {
+		t.Parallel()
+		var m *macaddr.MACAddress
+		assert.Nil(t, m.ModifiedEUI64())
+	})
+	t.Run("MACAddressFromModifiedEUI64()", func(t *testing.T) {
+		t.Parallel()
+		m, err := macaddr.MACAddressFromModifiedEUI64(macaddr.MustParseEUI64("02:00:5e:ff:fe:00:53:ab"))
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:53:ab", m.String())
+	})
+	t.Run("MACAddressFromModifiedEUI64() not derived", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.MACAddressFromModifiedEUI64(macaddr.MustParseEUI64("02:00:5e:10:00:00:00:01"))
+		assert.True(t, errors.Is(err, macaddr.ErrNotEUI64Derived))
+		_, err = macaddr.MACAddressFromModifiedEUI64(nil)
+		assert.True(t, errors.Is(err, macaddr.ErrNotEUI64Derived))
+	})
+}
+
+func Test_MACAddress_IPv6(t *testing.T) {
+	m := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+	t.Run("SLAAC", func(t *testing.T) {
+		t.Parallel()
+		a, err := m.IPv6(netip.MustParsePrefix("2001:db8:1:2::/64"))
+		require.NoError(t, err)
+		assert.Equal(t, "2001:db8:1:2:200:5eff:fe00:53ab", a.String())
+	})
+	t.Run("SLAAC unmasked prefix", func(t *testing.T) {
+		t.Parallel()
+		a, err := m.IPv6(netip.MustParsePrefix("2001:db8::1/48"))
+		require.NoError(t, err)
+		assert.Equal(t, "2001:db8::200:5eff:fe00:53ab", a.String())
+	})
+	t.Run("invalid prefixes", func(t *testing.T) {
+		t.Parallel()
+		for _, p := range []string{"2001:db8::/80", "192.0.2.0/24", "::ffff:192.0.2.0/120"} {
+			_, err := m.IPv6(netip.MustParsePrefix(p))
+			assert.Error(t, err, p)
+		}
+		_, err := m.IPv6(netip.Prefix{})
+		assert.Error(t, err)
+	})
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		var m *macaddr.MACAddress
+		_, err := m.IPv6(macaddr.LinkLocalPrefix)
+		assert.Error(t, err)
+		assert.False(t, m.LinkLocal().IsValid())
+	})
+	t.Run("LinkLocal()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "fe80::200:5eff:fe00:53ab", m.LinkLocal().String())
+	})
+}
+
+func Test_MACAddressFromIPv6(t *testing.T) {
+	t.Run("derived", func(t *testing.T) {
+		t.Parallel()
+		m, err := macaddr.MACAddressFromIPv6(netip.MustParseAddr("fe80::200:5eff:fe00:53ab"))
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:53:ab", m.String())
+	})
+	t.Run("not derived", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.MACAddressFromIPv6(netip.MustParseAddr("2001:db8::1"))
+		assert.True(t, errors.Is(err, macaddr.ErrNotEUI64Derived))
+	})
+	t.Run("not IPv6", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.MACAddressFromIPv6(netip.MustParseAddr("192.0.2.1"))
+		require.Error(t, err)
+		assert.False(t, errors.Is(err, macaddr.ErrNotEUI64Derived))
+	})
+}
+
+func ExampleMACAddress_IPv6() {
+	mac := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+	addr, err := mac.IPv6(netip.MustParsePrefix("2001:db8::/64"))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(addr)
+	fmt.Println(mac.LinkLocal())
+	// Output:
+	// 2001:db8::200:5eff:fe00:53ab
+	// fe80::200:5eff:fe00:53ab
+}
+
+func ExampleMACAddressFromIPv6() {
+	mac, err := macaddr.MACAddressFromIPv6(netip.MustParseAddr("fe80::200:5eff:fe00:53ab"))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(mac)
+	// Output:
+	// 00:00:5e:00:53:ab
+}
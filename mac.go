@@ -14,24 +14,32 @@ import (
 	"go.mdl.wtf/go-macaddr/internal/validate"
 )
 
-// MACAddress represents a single MAC Address, a slice of bytes. Currently, only 48-bit (EUI-48)
-// addresses are supported.
+// MACAddress represents a single MAC Address, a slice of bytes. Only 48-bit (EUI-48) addresses
+// are represented by MACAddress; see EUI64 for 64-bit addresses.
 type MACAddress []byte
 
-// ParseMACAddress parses an input string to a valid MACAddress object.
+// ParseMACAddress parses an input string to a valid MACAddress object. Input that is a complete
+// 64-bit or 20 byte address is rejected with a *ParseError wrapping a *LengthError rather than
+// truncated; use ParseAddress to accept any supported length. All errors are of type *ParseError.
 func ParseMACAddress(i string) (*MACAddress, error) {
-	if !validate.Hex(i) {
-		return nil, fmt.Errorf("'%v' contains non-hexadecimal characters", i)
+	if o := validate.FirstNonHex(i); o >= 0 {
+		return nil, errInvalidCharacter(i, o)
 	}
 	hw, err := net.ParseMAC(i)
 	if err != nil {
-		hw, err = net.ParseMAC(format.WithColons(format.PadMAC(i)))
+		h := format.PadMAC(i)
+		hw, err = net.ParseMAC(format.WithColons(h))
 		if err != nil {
-			return nil, err
+			if len(h) > constant.HexStrLen {
+				return nil, errInvalidLength(&LengthError{Input: i, Len: (len(h) + 1) / 2, Want: constant.MacByteLen})
+			}
+			return nil, errInvalidFormat(i, err)
 		}
 	}
-	mac := FromByteArray([]byte{hw[0], hw[1], hw[2], hw[3], hw[4], hw[5]})
-	return mac, nil
+	if len(hw) != constant.MacByteLen {
+		return nil, errInvalidLength(&LengthError{Input: i, Kind: KindFromLen(len(hw)), Len: len(hw), Want: constant.MacByteLen})
+	}
+	return FromByteArray(hw), nil
 }
 
 // MustParseMACAddress operates identically to ParseMACAddress, but panics on error instead of
@@ -86,6 +94,33 @@ func (m *MACAddress) Dashes() string { return m.Format(constant.FmtDash) }
 // NoSeparators formats the MAC Address with no separators, e.g. 'xx-xx-xx-xx-xx-xx'.
 func (m *MACAddress) NoSeparators() string { return m.Format(constant.FmtNone) }
 
+// MarshalText implements encoding.TextMarshaler, using the colon-separated format, e.g.
+// 'xx:xx:xx:xx:xx:xx'. An empty MACAddress marshals to an empty string.
+func (m MACAddress) MarshalText() ([]byte, error) {
+	if len(m) == 0 {
+		return []byte{}, nil
+	}
+	if len(m) != constant.MacByteLen {
+		return nil, fmt.Errorf("'%v' is an invalid MAC address", []byte(m))
+	}
+	return []byte(m.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the same input as
+// ParseMACAddress. An empty string unmarshals to an empty MACAddress.
+func (m *MACAddress) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*m = nil
+		return nil
+	}
+	mac, err := ParseMACAddress(string(text))
+	if err != nil {
+		return err
+	}
+	*m = *mac
+	return nil
+}
+
 // Int returns an integer representation of a MAC Address.
 func (m *MACAddress) Int() int64 {
 	if m == nil {
@@ -213,28 +248,26 @@ func (m *MACAddress) LEqual(o *MACAddress) bool {
 	return l || e
 }
 
+// Prefix returns the MACPrefix of length l containing the MACAddress. For example, a prefix length
+// of 24 and a MACAddress of 00:00:5e:00:53:ab would return 00:00:5e:00:00:00/24.
+func (m *MACAddress) Prefix(l int) (*MACPrefix, error) {
+	if m == nil || len(*m) != constant.MacByteLen {
+		return nil, fmt.Errorf("'%s' is an invalid MAC address", m.String())
+	}
+	if l < 0 || l > constant.MacBitLen {
+		return nil, fmt.Errorf("'%d' is an invalid MAC prefix length", l)
+	}
+	mask := MaskFromPrefixLen(l)
+	return &MACPrefix{MAC: m.Mask(mask), Mask: mask}, nil
+}
+
 // Format formats a MACAddress according to a string template. For example, a template of
 // xxxx.xxxx.xxxx and a MACAddress of 00:00:5e:00:53:ab would return a value of 0000.5e00.53ab.
 func (m *MACAddress) Format(f string) string {
 	if m == nil {
 		return "<nil>"
 	}
-	var p []string
-	offset := (4 - constant.MacBitLen) & 3
-	uc := m.Int() << offset
-
-	fmtStr := format.CreateFmtString(format.ReverseString(f))
-
-	for _, ch := range fmtStr {
-		if ch == 'x' {
-			n := uc & 0xf
-			p = append(p, constant.HexDigits[n])
-			uc >>= 4
-		} else {
-			p = append(p, string(ch))
-		}
-	}
-	return format.ReverseString(strings.Join(p, ""))
+	return format.Template(uint64(m.Int()), f)
 }
 
 // OUI returns the Organizationally Unique Identifier (OUI) of a MACAddress. If a prefix length is
@@ -1,8 +1,10 @@
 package macaddr
 
 import (
+	"errors"
 	"fmt"
 	"math"
+	"strings"
 
 	"go.mdl.wtf/go-macaddr/internal/constant"
 	"go.mdl.wtf/go-macaddr/internal/convert"
@@ -35,21 +37,27 @@ type MACPrefix struct {
 // # Return Values
 //
 // ParseMACPrefix returns the original input MAC Address as a valid MACAddress object, the
-// parsed MACPrefix object, and an error if parsing failed.
+// parsed MACPrefix object, and an error if parsing failed. All errors are of type *ParseError.
 func ParseMACPrefix(s string) (mac *MACAddress, mpo *MACPrefix, err error) {
+	if o := validate.FirstNonHexPrefix(s); o >= 0 {
+		return nil, nil, errInvalidCharacter(s, o)
+	}
 	str, l, err := validate.ParseMacAddrWithPrefixLen(s)
+	if errors.Is(err, validate.ErrPrefixLen) {
+		return nil, nil, errInvalidPrefixLen(s, strings.IndexByte(s, '/')+1)
+	}
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, errInvalidFormat(s, err)
 	}
 	mac, err = ParseMACAddress(str)
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, withInput(err, s)
 	}
 	ls := fmt.Sprint(l)
 
 	n, i, ok := convert.DecToInt(ls)
 	if mac == nil || !ok || i != len(ls) || n < 0 || n > constant.MacBitLen {
-		return nil, nil, fmt.Errorf("'%v' is an invalid MAC prefix", s)
+		return nil, nil, errInvalidPrefixLen(s, strings.IndexByte(s, '/')+1)
 	}
 	m := MaskFromPrefixLen(n)
 	var mp *MACPrefix = new(MACPrefix)
@@ -84,29 +92,63 @@ func (p *MACPrefix) String() string {
 	return fmt.Sprintf("%s/%d", p.MAC.String(), l)
 }
 
-// Match attempts to match the MACPrefix to an input string.
+// MarshalText implements encoding.TextMarshaler, using the same format as String, e.g.
+// 'xx:xx:xx:xx:xx:xx/24'. An empty MACPrefix marshals to an empty string.
+func (p MACPrefix) MarshalText() ([]byte, error) {
+	if p.MAC == nil && p.Mask == nil {
+		return []byte{}, nil
+	}
+	if p.MAC == nil || p.Mask == nil {
+		return nil, fmt.Errorf("MACPrefix is missing its MAC or Mask")
+	}
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, accepting the same input as ParseMACPrefix.
+// An empty string unmarshals to an empty MACPrefix.
+func (p *MACPrefix) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*p = MACPrefix{}
+		return nil
+	}
+	_, mp, err := ParseMACPrefix(string(text))
+	if err != nil {
+		return err
+	}
+	*p = *mp
+	return nil
+}
+
+// Match attempts to match the MACPrefix to an input string. All errors are of type *ParseError;
+// input that parses but is not contained within the MACPrefix returns an error matching
+// ErrNotContained.
 func (p *MACPrefix) Match(i string) (m *MACPrefix, e error) {
-	e = fmt.Errorf("'%v' is not contained within MACPrefix %s", i, p.String())
+	if o := validate.FirstNonHexPrefix(i); o >= 0 {
+		return nil, errInvalidCharacter(i, o)
+	}
 	str, l, err := validate.ParseMacAddrWithPrefixLen(i)
+	if errors.Is(err, validate.ErrPrefixLen) || (err == nil && l > constant.MacBitLen) {
+		return nil, errInvalidPrefixLen(i, strings.IndexByte(i, '/')+1)
+	}
 	if err != nil {
-		return nil, err
+		return nil, errInvalidFormat(i, err)
 	}
 	addr, err := ParseMACAddress(str)
 	if err != nil {
-		return nil, err
+		return nil, withInput(err, i)
 	}
 	if l < p.PrefixLen() {
-		return nil, e
+		return nil, errNotContained(i, p)
 	}
 	if p.Contains(addr) {
 		return p, nil
 	}
-	return nil, e
+	return nil, errNotContained(i, p)
 }
 
 // Contains determines if an input MACAddress is contained within this MACPrefix.
 func (p *MACPrefix) Contains(mac *MACAddress) bool {
-	if p == nil {
+	if !p.covers(0) || mac == nil {
 		return false
 	}
 	mask := *p.Mask
@@ -234,3 +276,99 @@ func (p *MACPrefix) Iter() *MACPrefixIterator {
 		runs:    0,
 	}
 }
+
+// span returns the base address and mask of the MACPrefix as integers. The base is masked, as the
+// MAC of a MACPrefix built directly may have host bits set.
+func (p *MACPrefix) span() (base, mask uint64) {
+	mask = uint64(p.Mask.Int())
+	return uint64(p.MAC.Int()) & mask, mask
+}
+
+// Relation is the relationship between two MACPrefixes.
+type Relation int
+
+const (
+	// RelationDisjoint is two MACPrefixes that have no addresses in common.
+	RelationDisjoint Relation = iota
+	// RelationEqual is two MACPrefixes containing the same addresses.
+	RelationEqual
+	// RelationSubset is a MACPrefix contained within, and smaller than, another MACPrefix.
+	RelationSubset
+	// RelationSuperset is a MACPrefix containing, and larger than, another MACPrefix.
+	RelationSuperset
+)
+
+// String returns the name of the Relation, e.g. 'subset'.
+func (r Relation) String() string {
+	switch r {
+	case RelationEqual:
+		return "equal"
+	case RelationSubset:
+		return "subset"
+	case RelationSuperset:
+		return "superset"
+	}
+	return "disjoint"
+}
+
+// ContainsPrefix determines if every address in an input MACPrefix is contained within this
+// MACPrefix.
+func (p *MACPrefix) ContainsPrefix(o *MACPrefix) bool {
+	if !p.covers(0) || !o.covers(0) {
+		return false
+	}
+	if p.PrefixLen() > o.PrefixLen() {
+		return false
+	}
+	base, mask := p.span()
+	ob, _ := o.span()
+	return ob&mask == base
+}
+
+// Overlaps determines if this MACPrefix and an input MACPrefix have any addresses in common.
+// Because MACPrefixes are bit-aligned, two MACPrefixes overlap only if one contains the other.
+func (p *MACPrefix) Overlaps(o *MACPrefix) bool {
+	return p.ContainsPrefix(o) || o.ContainsPrefix(p)
+}
+
+// Relation returns the relationship of this MACPrefix to an input MACPrefix. For example,
+// 00:00:5e:00:53:00/40 is a subset of 00:00:5e:00:00:00/24.
+func (p *MACPrefix) Relation(o *MACPrefix) Relation {
+	switch in, out := p.ContainsPrefix(o), o.ContainsPrefix(p); {
+	case in && out:
+		return RelationEqual
+	case in:
+		return RelationSuperset
+	case out:
+		return RelationSubset
+	}
+	return RelationDisjoint
+}
+
+// Supernet returns the MACPrefix of length l containing this MACPrefix. For example, a prefix
+// length of 24 and a MACPrefix of 00:00:5e:00:53:00/40 would return 00:00:5e:00:00:00/24. l must
+// not be greater than the MACPrefix's prefix length.
+func (p *MACPrefix) Supernet(l int) (*MACPrefix, error) {
+	if !p.covers(0) {
+		return nil, fmt.Errorf("'%s' is an invalid MAC prefix", p.String())
+	}
+	if l < 0 || l > p.PrefixLen() {
+		return nil, fmt.Errorf("'%d' is an invalid supernet prefix length for %s", l, p.String())
+	}
+	return p.MAC.Prefix(l)
+}
+
+// Sibling returns the MACPrefix of the same length that, combined with this MACPrefix, forms the
+// MACPrefix one bit shorter. For example, the sibling of 00:00:5e:00:53:00/40 is
+// 00:00:5e:00:52:00/40. A MACPrefix of length 0 has no sibling.
+func (p *MACPrefix) Sibling() (*MACPrefix, error) {
+	if !p.covers(0) {
+		return nil, fmt.Errorf("'%s' is an invalid MAC prefix", p.String())
+	}
+	l := p.PrefixLen()
+	if l == 0 {
+		return nil, fmt.Errorf("'%s' has no sibling", p.String())
+	}
+	base, _ := p.span()
+	return AddrFromUint64(base ^ 1<<(constant.MacBitLen-l)).MACAddress().Prefix(l)
+}
@@ -1,6 +1,7 @@
 package macaddr_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
 	"testing"
@@ -45,6 +46,44 @@ func Test_ParseMACPrefix(t *testing.T) {
 	})
 }
 
+func Test_MACPrefix_Text(t *testing.T) {
+	type payload struct {
+		Prefix macaddr.MACPrefix `json:"prefix"`
+	}
+	t.Run("MarshalText()", func(t *testing.T) {
+		t.Parallel()
+		_, p := macaddr.MustParseMACPrefix("00:00:5e:00:53:00/24")
+		b, err := p.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:00:00/24", string(b))
+		b, err = macaddr.MACPrefix{}.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, b)
+		_, err = macaddr.MACPrefix{MAC: p.MAC}.MarshalText()
+		require.Error(t, err)
+	})
+	t.Run("UnmarshalText()", func(t *testing.T) {
+		t.Parallel()
+		var p macaddr.MACPrefix
+		require.NoError(t, p.UnmarshalText([]byte("00:00:5e:00:53:00/28")))
+		assert.Equal(t, "00:00:5e:00:00:00/28", p.String())
+		require.NoError(t, p.UnmarshalText([]byte{}))
+		assert.Nil(t, p.MAC)
+		assert.False(t, p.Contains(macaddr.MustParseMACAddress("00:00:5e:00:53:01")))
+		require.Error(t, p.UnmarshalText([]byte("this should error")))
+	})
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+		_, p := macaddr.MustParseMACPrefix("00:00:5e:00:53:00/24")
+		b, err := json.Marshal(payload{Prefix: *p})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"prefix":"00:00:5e:00:00:00/24"}`, string(b))
+		var out payload
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, p.String(), out.Prefix.String())
+	})
+}
+
 func Test_MACPrefix(t *testing.T) {
 	s := "01:23:45:67:89:ab/24"
 	_, mp, err := macaddr.ParseMACPrefix(s)
@@ -258,6 +297,97 @@ func Test_MACPrefix(t *testing.T) {
 	})
 }
 
+func Test_MACPrefix_Relations(t *testing.T) {
+	parse := func(s string) *macaddr.MACPrefix {
+		_, p := macaddr.MustParseMACPrefix(s)
+		return p
+	}
+	oui := parse("00:00:5e:00:00:00/24")
+	doc := parse("00:00:5e:00:53:00/40")
+	other := parse("00:00:5f:00:00:00/24")
+	t.Run("ContainsPrefix()", func(t *testing.T) {
+		t.Parallel()
+		assert.True(t, oui.ContainsPrefix(doc))
+		assert.True(t, oui.ContainsPrefix(oui))
+		assert.False(t, doc.ContainsPrefix(oui))
+		assert.False(t, oui.ContainsPrefix(other))
+		assert.True(t, parse("00:00:00:00:00:00/0").ContainsPrefix(doc))
+		assert.False(t, oui.ContainsPrefix(nil))
+	})
+	t.Run("Overlaps()", func(t *testing.T) {
+		t.Parallel()
+		assert.True(t, oui.Overlaps(doc))
+		assert.True(t, doc.Overlaps(oui))
+		assert.False(t, oui.Overlaps(other))
+		var n *macaddr.MACPrefix
+		assert.False(t, n.Overlaps(oui))
+	})
+	t.Run("Relation()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, macaddr.RelationSuperset, oui.Relation(doc))
+		assert.Equal(t, macaddr.RelationSubset, doc.Relation(oui))
+		assert.Equal(t, macaddr.RelationEqual, oui.Relation(parse("00:00:5e:12:34:56/24")))
+		assert.Equal(t, macaddr.RelationDisjoint, oui.Relation(other))
+		assert.Equal(t, "subset", macaddr.RelationSubset.String())
+		assert.Equal(t, "disjoint", macaddr.RelationDisjoint.String())
+	})
+	t.Run("Supernet()", func(t *testing.T) {
+		t.Parallel()
+		s, err := doc.Supernet(24)
+		require.NoError(t, err)
+		assert.Equal(t, oui.String(), s.String())
+		s, err = doc.Supernet(40)
+		require.NoError(t, err)
+		assert.Equal(t, doc.String(), s.String())
+		_, err = oui.Supernet(25)
+		assert.Error(t, err)
+		_, err = oui.Supernet(-1)
+		assert.Error(t, err)
+	})
+	t.Run("Sibling()", func(t *testing.T) {
+		t.Parallel()
+		s, err := doc.Sibling()
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:52:00/40", s.String())
+		s, err = parse("00:00:5e:00:53:ab/48").Sibling()
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:53:aa/48", s.String())
+		s, err = parse("80:00:00:00:00:00/1").Sibling()
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:00:00:00:00/1", s.String())
+		_, err = parse("00:00:00:00:00:00/0").Sibling()
+		assert.Error(t, err)
+		var n *macaddr.MACPrefix
+		_, err = n.Sibling()
+		assert.Error(t, err)
+	})
+	t.Run("host bits", func(t *testing.T) {
+		t.Parallel()
+		p := &macaddr.MACPrefix{MAC: macaddr.MustParseMACAddress("00:00:5e:12:34:56"), Mask: oui.Mask}
+		sub := parse("00:00:5e:12:00:00/32")
+		assert.True(t, p.Contains(sub.MAC))
+		assert.True(t, p.ContainsPrefix(sub))
+		assert.True(t, p.Overlaps(doc))
+		assert.Equal(t, macaddr.RelationEqual, p.Relation(oui))
+		assert.Equal(t, macaddr.RelationSuperset, p.Relation(sub))
+		s, err := p.Sibling()
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5f:00:00:00/24", s.String())
+	})
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+		z := &macaddr.MACPrefix{}
+		assert.False(t, z.ContainsPrefix(oui))
+		assert.False(t, oui.ContainsPrefix(z))
+		assert.False(t, z.Overlaps(oui))
+		assert.Equal(t, macaddr.RelationDisjoint, z.Relation(oui))
+		_, err := z.Supernet(0)
+		assert.Error(t, err)
+		_, err = z.Sibling()
+		assert.Error(t, err)
+	})
+}
+
 func ExampleParseMACPrefix() {
 	mac, macPrefix, err := macaddr.ParseMACPrefix("00:00:5e:00:53:00/24")
 	if err != nil {
@@ -396,3 +526,13 @@ func ExampleMACPrefix_Iter() {
 	// 00:00:5e:00:53:0e
 	// 00:00:5e:00:53:0f
 }
+
+func ExampleMACPrefix_Relation() {
+	_, oui := macaddr.MustParseMACPrefix("00:00:5e:00:00:00/24")
+	_, doc := macaddr.MustParseMACPrefix("00:00:5e:00:53:00/40")
+	fmt.Println(doc.Relation(oui))
+	fmt.Println(oui.Overlaps(doc))
+	// Output:
+	// subset
+	// true
+}
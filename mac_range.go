@@ -0,0 +1,167 @@
+package macaddr
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// MACRange represents an inclusive range of MAC Addresses, e.g.
+// 00:50:56:00:00:00-00:50:56:3f:ff:ff. Unlike a MACPrefix, a MACRange need not be bit-aligned.
+type MACRange struct {
+	// First is the first MAC Address in the range.
+	First *MACAddress
+	// Last is the last MAC Address in the range.
+	Last *MACAddress
+}
+
+// MACRangeIterator tracks iteration state while iterating through a MACRange.
+type MACRangeIterator struct {
+	current uint64
+	last    uint64
+	runs    int
+	done    bool
+}
+
+// NewMACRange creates a MACRange from its first and last MAC Addresses. An error is returned if
+// either MAC Address is invalid, or first is greater than last.
+func NewMACRange(first, last *MACAddress) (*MACRange, error) {
+	if first == nil || len(*first) != constant.MacByteLen || last == nil || len(*last) != constant.MacByteLen {
+		return nil, fmt.Errorf("'%s-%s' is an invalid MAC range", first.String(), last.String())
+	}
+	if first.GreaterThan(last) {
+		return nil, fmt.Errorf("'%s' is greater than '%s'", first.String(), last.String())
+	}
+	return &MACRange{First: first.Clone(), Last: last.Clone()}, nil
+}
+
+// ParseMACRange parses a MACRange from two MAC Addresses separated by a dash, e.g.
+// '00:50:56:00:00:00-00:50:56:3f:ff:ff'. Each MAC Address is parsed with StrictParseOptions, and
+// may be surrounded by whitespace. Dash-separated MAC Addresses are supported, e.g.
+// '00-50-56-00-00-00-00-50-56-3f-ff-ff'. All errors are of type *ParseError.
+func ParseMACRange(s string) (*MACRange, error) {
+	for i := 0; i < len(s); i++ {
+		if s[i] != '-' {
+			continue
+		}
+		first, err := StrictParseOptions.ParseMACAddress(strings.TrimSpace(s[:i]))
+		if err != nil {
+			continue
+		}
+		last, err := StrictParseOptions.ParseMACAddress(strings.TrimSpace(s[i+1:]))
+		if err != nil {
+			continue
+		}
+		if first.GreaterThan(last) {
+			return nil, &ParseError{Kind: ErrorInvalidFormat, Input: s, Offset: i + 1}
+		}
+		return &MACRange{First: first, Last: last}, nil
+	}
+	return nil, &ParseError{Kind: ErrorInvalidFormat, Input: s, Offset: -1}
+}
+
+// MustParseMACRange operates identically to ParseMACRange, but panics on error instead of
+// returning the error. Most ideal for tests.
+func MustParseMACRange(s string) *MACRange {
+	r, err := ParseMACRange(s)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// Range returns the MACRange of addresses in the MACPrefix, from First to Last.
+func (p *MACPrefix) Range() *MACRange {
+	if !p.covers(0) {
+		return nil
+	}
+	return &MACRange{First: p.First(), Last: p.Last()}
+}
+
+// valid determines if the MACRange has a first and last MAC Address.
+func (r *MACRange) valid() bool {
+	return r != nil && r.First != nil && len(*r.First) == constant.MacByteLen &&
+		r.Last != nil && len(*r.Last) == constant.MacByteLen
+}
+
+// bounds returns the first and last MAC Addresses of the MACRange as integers.
+func (r *MACRange) bounds() (first, last uint64) {
+	return r.First.Addr().Uint64(), r.Last.Addr().Uint64()
+}
+
+// String returns a dash-separated string representation of the MACRange, e.g.
+// '00:50:56:00:00:00-00:50:56:3f:ff:ff'.
+func (r *MACRange) String() string {
+	if !r.valid() {
+		return constant.NilStr
+	}
+	return fmt.Sprintf("%s-%s", r.First.String(), r.Last.String())
+}
+
+// Contains determines if an input MACAddress is contained within the MACRange.
+func (r *MACRange) Contains(mac *MACAddress) bool {
+	if !r.valid() || mac == nil || len(*mac) != constant.MacByteLen {
+		return false
+	}
+	return mac.GEqual(r.First) && mac.LEqual(r.Last)
+}
+
+// Count returns the number of MAC Addresses in the MACRange.
+func (r *MACRange) Count() int {
+	if !r.valid() {
+		return 0
+	}
+	first, last := r.bounds()
+	if first > last {
+		return 0
+	}
+	return int(last-first) + 1
+}
+
+// Prefixes returns the minimal list of MACPrefixes containing exactly the addresses of the
+// MACRange, in ascending order. For example, 00:00:5e:00:53:00-00:00:5e:00:53:ff returns
+// 00:00:5e:00:53:00/40.
+func (r *MACRange) Prefixes() []*MACPrefix {
+	if !r.valid() {
+		return nil
+	}
+	first, last := r.bounds()
+	if first > last {
+		return nil
+	}
+	return rangePrefixes(first, last)
+}
+
+// Next iterates through the MACRange.
+func (i *MACRangeIterator) Next() bool {
+	if i == nil || i.done {
+		return false
+	}
+	if i.runs > 0 {
+		if i.current >= i.last {
+			i.done = true
+			return false
+		}
+		i.current++
+	}
+	i.runs++
+	return true
+}
+
+// Value returns the current iteration value.
+func (i *MACRangeIterator) Value() *MACAddress {
+	if i == nil || i.runs == 0 || i.done {
+		panic(fmt.Errorf("cannot call Value() before Next() or after iterator has finished"))
+	}
+	return AddrFromUint64(i.current).MACAddress()
+}
+
+// Iter creates an iterator for the MACRange.
+func (r *MACRange) Iter() *MACRangeIterator {
+	if !r.valid() {
+		return nil
+	}
+	first, last := r.bounds()
+	return &MACRangeIterator{current: first, last: last, done: first > last}
+}
@@ -0,0 +1,141 @@
+package macaddr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_ParseMACRange(t *testing.T) {
+	type pair struct {
+		in  string
+		out string
+	}
+	tests := []pair{
+		{"00:50:56:00:00:00-00:50:56:3f:ff:ff", "00:50:56:00:00:00-00:50:56:3f:ff:ff"},
+		{"00:50:56:00:00:00 - 00:50:56:3f:ff:ff", "00:50:56:00:00:00-00:50:56:3f:ff:ff"},
+		{"00-50-56-00-00-00-00-50-56-3f-ff-ff", "00:50:56:00:00:00-00:50:56:3f:ff:ff"},
+		{"0050.5600.0000-0050.563f.ffff", "00:50:56:00:00:00-00:50:56:3f:ff:ff"},
+		{"005056000000-00-50-56-3f-ff-ff", "00:50:56:00:00:00-00:50:56:3f:ff:ff"},
+		{"00:00:5e:00:53:ab-00:00:5e:00:53:ab", "00:00:5e:00:53:ab-00:00:5e:00:53:ab"},
+	}
+	for _, p := range tests {
+		p := p
+		t.Run(p.in, func(t *testing.T) {
+			t.Parallel()
+			r, err := macaddr.ParseMACRange(p.in)
+			require.NoError(t, err)
+			assert.Equal(t, p.out, r.String())
+		})
+	}
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range []string{
+			"this should error",
+			"00:50:56:00:00:00",
+			"00:50:56:3f:ff:ff-00:50:56:00:00:00",
+			"00:50:56:00:00-00:50:56:3f:ff:ff",
+			"00-50-56-00-00-00-00-50-56-3f-ff",
+		} {
+			_, err := macaddr.ParseMACRange(s)
+			var pe *macaddr.ParseError
+			assert.True(t, errors.As(err, &pe), s)
+			assert.True(t, errors.Is(err, macaddr.ErrInvalidFormat), s)
+		}
+		assert.Panics(t, func() { macaddr.MustParseMACRange("this should panic") })
+	})
+}
+
+func Test_NewMACRange(t *testing.T) {
+	a := macaddr.MustParseMACAddress("00:00:5e:00:53:00")
+	b := macaddr.MustParseMACAddress("00:00:5e:00:53:ff")
+	r, err := macaddr.NewMACRange(a, b)
+	require.NoError(t, err)
+	assert.Equal(t, "00:00:5e:00:53:00-00:00:5e:00:53:ff", r.String())
+	_, err = macaddr.NewMACRange(b, a)
+	assert.Error(t, err)
+	_, err = macaddr.NewMACRange(nil, a)
+	assert.Error(t, err)
+}
+
+func Test_MACRange(t *testing.T) {
+	r := macaddr.MustParseMACRange("00:00:5e:00:53:01-00:00:5e:00:53:0a")
+	t.Run("Contains()", func(t *testing.T) {
+		t.Parallel()
+		assert.True(t, r.Contains(macaddr.MustParseMACAddress("00:00:5e:00:53:01")))
+		assert.True(t, r.Contains(macaddr.MustParseMACAddress("00:00:5e:00:53:0a")))
+		assert.False(t, r.Contains(macaddr.MustParseMACAddress("00:00:5e:00:53:00")))
+		assert.False(t, r.Contains(macaddr.MustParseMACAddress("00:00:5e:00:53:0b")))
+		assert.False(t, r.Contains(nil))
+	})
+	t.Run("Count()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, 10, r.Count())
+		assert.Equal(t, 1<<48, macaddr.MustParseMACRange("00:00:00:00:00:00-ff:ff:ff:ff:ff:ff").Count())
+	})
+	t.Run("Prefixes()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, []string{
+			"00:00:5e:00:53:01/48",
+			"00:00:5e:00:53:02/47",
+			"00:00:5e:00:53:04/46",
+			"00:00:5e:00:53:08/47",
+			"00:00:5e:00:53:0a/48",
+		}, prefixStrings(r.Prefixes()))
+		vmware := macaddr.MustParseMACRange("00:50:56:00:00:00-00:50:56:3f:ff:ff")
+		assert.Equal(t, []string{"00:50:56:00:00:00/26"}, prefixStrings(vmware.Prefixes()))
+		all := macaddr.MustParseMACRange("00:00:00:00:00:00-ff:ff:ff:ff:ff:ff")
+		assert.Equal(t, []string{"00:00:00:00:00:00/0"}, prefixStrings(all.Prefixes()))
+	})
+	t.Run("Iter()", func(t *testing.T) {
+		t.Parallel()
+		iter := r.Iter()
+		var out []string
+		for iter.Next() {
+			out = append(out, iter.Value().String())
+		}
+		assert.Len(t, out, 10)
+		assert.Equal(t, "00:00:5e:00:53:01", out[0])
+		assert.Equal(t, "00:00:5e:00:53:0a", out[9])
+		assert.False(t, iter.Next())
+		assert.Panics(t, func() { iter.Value() })
+		last := macaddr.MustParseMACRange("ff:ff:ff:ff:ff:fe-ff:ff:ff:ff:ff:ff").Iter()
+		n := 0
+		for last.Next() {
+			n++
+		}
+		assert.Equal(t, 2, n)
+	})
+	t.Run("MACPrefix.Range()", func(t *testing.T) {
+		t.Parallel()
+		_, p := macaddr.MustParseMACPrefix("00:50:56:00:00:00/26")
+		assert.Equal(t, "00:50:56:00:00:00-00:50:56:3f:ff:ff", p.Range().String())
+		var n *macaddr.MACPrefix
+		assert.Nil(t, n.Range())
+		assert.Nil(t, (&macaddr.MACPrefix{}).Range())
+	})
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		var n *macaddr.MACRange
+		assert.Equal(t, "<nil>", n.String())
+		assert.Zero(t, n.Count())
+		assert.Nil(t, n.Prefixes())
+		assert.Nil(t, n.Iter())
+		var ni *macaddr.MACRangeIterator
+		assert.False(t, ni.Next())
+	})
+}
+
+func ExampleMACRange_Prefixes() {
+	r := macaddr.MustParseMACRange("00:00:5e:00:53:00-00:00:5e:00:54:7f")
+	for _, p := range r.Prefixes() {
+		fmt.Println(p.String())
+	}
+	// Output:
+	// 00:00:5e:00:53:00/40
+	// 00:00:5e:00:54:00/41
+}
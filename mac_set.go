@@ -0,0 +1,298 @@
+package macaddr
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// MACSetBuilder builds an immutable MACSet. The zero value is an empty builder ready to use.
+//
+// Operations are applied in order, so adding a MACPrefix and then removing an address from it
+// results in a MACSet containing every address in the MACPrefix except the removed address.
+// Invalid input, e.g. a nil MACAddress, is recorded and returned as an error by MACSet.
+//
+// Consecutive additions and removals are collected and applied together, so building a MACSet
+// from n entries takes O(n log n) time.
+type MACSetBuilder struct {
+	// rr is normalized. The contents of the builder are rr and in, less out.
+	rr []addrRange
+	// in is the ranges added since rr was last normalized.
+	in []addrRange
+	// out is the ranges removed since rr was last normalized, after in was added.
+	out  []addrRange
+	errs []error
+}
+
+// MACSet is an immutable set of MAC Addresses, built with a MACSetBuilder. The zero value is a
+// valid, empty MACSet.
+type MACSet struct {
+	// rr is sorted, non-overlapping and non-adjacent.
+	rr []addrRange
+}
+
+// subtractRanges returns the ranges of a not in b, both normalized.
+func subtractRanges(a, b []addrRange) []addrRange {
+	var out []addrRange
+	j := 0
+	for _, r := range a {
+		for j < len(b) && b[j].last < r.first {
+			j++
+		}
+		first := r.first
+		for k := j; k < len(b) && b[k].first <= r.last; k++ {
+			if b[k].first > first {
+				out = append(out, addrRange{first: first, last: b[k].first - 1})
+			}
+			if b[k].last >= r.last {
+				first = r.last + 1
+				break
+			}
+			first = b[k].last + 1
+		}
+		if first <= r.last {
+			out = append(out, addrRange{first: first, last: r.last})
+		}
+	}
+	return out
+}
+
+// intersectRanges returns the ranges in both a and b, both normalized.
+func intersectRanges(a, b []addrRange) []addrRange {
+	var out []addrRange
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		first, last := max(a[i].first, b[j].first), min(a[i].last, b[j].last)
+		if first <= last {
+			out = append(out, addrRange{first: first, last: last})
+		}
+		if a[i].last < b[j].last {
+			i++
+		} else {
+			j++
+		}
+	}
+	return out
+}
+
+// prefixRange returns the range of a MACPrefix, or records an error if it is invalid.
+func (b *MACSetBuilder) prefixRange(p *MACPrefix) (addrRange, bool) {
+	if p == nil || p.MAC == nil || p.Mask == nil || len(*p.MAC) != constant.MacByteLen || p.PrefixLen() < 0 {
+		b.errs = append(b.errs, fmt.Errorf("'%s' is an invalid MAC prefix", p.String()))
+		return addrRange{}, false
+	}
+	base, mask := p.span()
+	return addrRange{first: base, last: base | addrBits&^mask}, true
+}
+
+// macRange returns the range of a MACRange, or records an error if it is invalid.
+func (b *MACSetBuilder) macRange(r *MACRange) (addrRange, bool) {
+	if !r.valid() || r.First.GreaterThan(r.Last) {
+		b.errs = append(b.errs, fmt.Errorf("'%s' is an invalid MAC range", r.String()))
+		return addrRange{}, false
+	}
+	first, last := r.bounds()
+	return addrRange{first: first, last: last}, true
+}
+
+// addrRangeOf returns the range of a single MACAddress, or records an error if it is invalid.
+func (b *MACSetBuilder) addrRangeOf(mac *MACAddress) (addrRange, bool) {
+	if mac == nil || len(*mac) != constant.MacByteLen {
+		b.errs = append(b.errs, fmt.Errorf("'%s' is an invalid MAC address", mac.String()))
+		return addrRange{}, false
+	}
+	v := mac.Addr().Uint64()
+	return addrRange{first: v, last: v}, true
+}
+
+// normalize applies the pending additions and removals to the builder.
+func (b *MACSetBuilder) normalize() {
+	if len(b.in) > 0 {
+		b.rr = mergeRanges(append(b.rr, b.in...))
+		b.in = b.in[:0]
+	}
+	if len(b.out) > 0 {
+		b.rr = subtractRanges(b.rr, mergeRanges(b.out))
+		b.out = b.out[:0]
+	}
+}
+
+// add adds ranges to the builder. Pending removals are applied first, as they must not affect
+// the added ranges.
+func (b *MACSetBuilder) add(rr ...addrRange) {
+	if len(b.out) > 0 {
+		b.normalize()
+	}
+	b.in = append(b.in, rr...)
+}
+
+// remove removes ranges from the builder.
+func (b *MACSetBuilder) remove(rr ...addrRange) {
+	b.out = append(b.out, rr...)
+}
+
+// addOne adds a range to the builder if it is valid.
+func (b *MACSetBuilder) addOne(r addrRange, ok bool) {
+	if ok {
+		b.add(r)
+	}
+}
+
+// removeOne removes a range from the builder if it is valid.
+func (b *MACSetBuilder) removeOne(r addrRange, ok bool) {
+	if ok {
+		b.remove(r)
+	}
+}
+
+// Add adds a MACAddress to the set.
+func (b *MACSetBuilder) Add(mac *MACAddress) { b.addOne(b.addrRangeOf(mac)) }
+
+// AddPrefix adds every address in a MACPrefix to the set.
+func (b *MACSetBuilder) AddPrefix(p *MACPrefix) { b.addOne(b.prefixRange(p)) }
+
+// AddRange adds every address in a MACRange to the set.
+func (b *MACSetBuilder) AddRange(r *MACRange) { b.addOne(b.macRange(r)) }
+
+// AddSet adds every address in a MACSet to the set, i.e. the union of the sets.
+func (b *MACSetBuilder) AddSet(s *MACSet) {
+	if s != nil {
+		b.add(s.rr...)
+	}
+}
+
+// Remove removes a MACAddress from the set.
+func (b *MACSetBuilder) Remove(mac *MACAddress) { b.removeOne(b.addrRangeOf(mac)) }
+
+// RemovePrefix removes every address in a MACPrefix from the set.
+func (b *MACSetBuilder) RemovePrefix(p *MACPrefix) { b.removeOne(b.prefixRange(p)) }
+
+// RemoveRange removes every address in a MACRange from the set.
+func (b *MACSetBuilder) RemoveRange(r *MACRange) { b.removeOne(b.macRange(r)) }
+
+// RemoveSet removes every address in a MACSet from the set, i.e. the difference of the sets.
+func (b *MACSetBuilder) RemoveSet(s *MACSet) {
+	if s != nil {
+		b.remove(s.rr...)
+	}
+}
+
+// Intersect removes every address not in a MACSet from the set, i.e. the intersection of the
+// sets.
+func (b *MACSetBuilder) Intersect(s *MACSet) {
+	b.normalize()
+	if s == nil {
+		b.rr = nil
+		return
+	}
+	b.rr = intersectRanges(b.rr, s.rr)
+}
+
+// Complement replaces the set with every address not in it.
+func (b *MACSetBuilder) Complement() {
+	b.normalize()
+	b.rr = subtractRanges([]addrRange{{first: 0, last: addrBits}}, b.rr)
+}
+
+// MACSet returns an immutable MACSet of the current contents of the builder. The builder may
+// continue to be used. If any invalid input was added to or removed from the builder, the MACSet
+// is returned along with an error describing the invalid input.
+func (b *MACSetBuilder) MACSet() (*MACSet, error) {
+	b.normalize()
+	rr := make([]addrRange, len(b.rr))
+	copy(rr, b.rr)
+	return &MACSet{rr: rr}, errors.Join(b.errs...)
+}
+
+// find returns the range of the MACSet containing v.
+func (s *MACSet) find(v uint64) (addrRange, bool) {
+	if s == nil {
+		return addrRange{}, false
+	}
+	i := sort.Search(len(s.rr), func(i int) bool { return s.rr[i].last >= v })
+	if i < len(s.rr) && s.rr[i].first <= v {
+		return s.rr[i], true
+	}
+	return addrRange{}, false
+}
+
+// Contains determines if a MACAddress is in the set.
+func (s *MACSet) Contains(mac *MACAddress) bool {
+	if mac == nil || len(*mac) != constant.MacByteLen {
+		return false
+	}
+	return s.ContainsAddr(mac.Addr())
+}
+
+// ContainsAddr determines if an Addr is in the set.
+func (s *MACSet) ContainsAddr(a Addr) bool {
+	if !a.IsValid() {
+		return false
+	}
+	_, ok := s.find(a.Uint64())
+	return ok
+}
+
+// ContainsPrefix determines if every address in a MACPrefix is in the set.
+func (s *MACSet) ContainsPrefix(p *MACPrefix) bool {
+	var b MACSetBuilder
+	r, ok := b.prefixRange(p)
+	return ok && s.containsRange(r)
+}
+
+// ContainsRange determines if every address in a MACRange is in the set.
+func (s *MACSet) ContainsRange(mr *MACRange) bool {
+	var b MACSetBuilder
+	r, ok := b.macRange(mr)
+	return ok && s.containsRange(r)
+}
+
+// containsRange determines if every address in r is in the set.
+func (s *MACSet) containsRange(r addrRange) bool {
+	f, ok := s.find(r.first)
+	return ok && f.last >= r.last
+}
+
+// Equal determines if two MACSets contain the same addresses.
+func (s *MACSet) Equal(o *MACSet) bool {
+	return slices.Equal(s.ranges(), o.ranges())
+}
+
+// ranges returns the ranges of the MACSet, which may be nil.
+func (s *MACSet) ranges() []addrRange {
+	if s == nil {
+		return nil
+	}
+	return s.rr
+}
+
+// Len returns the number of addresses in the set.
+func (s *MACSet) Len() int {
+	n := 0
+	for _, r := range s.ranges() {
+		n += int(r.last-r.first) + 1
+	}
+	return n
+}
+
+// Prefixes returns the minimal list of MACPrefixes containing exactly the addresses in the set, in
+// ascending order.
+func (s *MACSet) Prefixes() []*MACPrefix {
+	var out []*MACPrefix
+	for _, r := range s.ranges() {
+		out = append(out, rangePrefixes(r.first, r.last)...)
+	}
+	return out
+}
+
+// Ranges returns the minimal list of MACRanges containing exactly the addresses in the set, in
+// ascending order.
+func (s *MACSet) Ranges() []*MACRange {
+	var out []*MACRange
+	for _, r := range s.ranges() {
+		out = append(out, &MACRange{First: AddrFromUint64(r.first).MACAddress(), Last: AddrFromUint64(r.last).MACAddress()})
+	}
+	return out
+}
@@ -0,0 +1,236 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func rangeStrings(ranges []*macaddr.MACRange) []string {
+	out := make([]string, 0, len(ranges))
+	for _, r := range ranges {
+		out = append(out, r.String())
+	}
+	return out
+}
+
+func Test_MACSetBuilder(t *testing.T) {
+	prefix := func(s string) *macaddr.MACPrefix {
+		_, p := macaddr.MustParseMACPrefix(s)
+		return p
+	}
+	mac := macaddr.MustParseMACAddress
+	t.Run("Add()", func(t *testing.T) {
+		t.Parallel()
+		var b macaddr.MACSetBuilder
+		b.Add(mac("00:00:5e:00:53:01"))
+		b.Add(mac("00:00:5e:00:53:00"))
+		b.Add(mac("00:00:5e:00:53:03"))
+		s, err := b.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"00:00:5e:00:53:00-00:00:5e:00:53:01", "00:00:5e:00:53:03-00:00:5e:00:53:03"}, rangeStrings(s.Ranges()))
+		assert.Equal(t, []string{"00:00:5e:00:53:00/47", "00:00:5e:00:53:03/48"}, prefixStrings(s.Prefixes()))
+		assert.Equal(t, 3, s.Len())
+	})
+	t.Run("RemovePrefix()", func(t *testing.T) {
+		t.Parallel()
+		var b macaddr.MACSetBuilder
+		b.AddPrefix(prefix("00:00:5e:00:00:00/24"))
+		b.RemovePrefix(prefix("00:00:5e:00:00:00/25"))
+		b.Remove(mac("00:00:5e:ff:ff:ff"))
+		s, err := b.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"00:00:5e:80:00:00-00:00:5e:ff:ff:fe"}, rangeStrings(s.Ranges()))
+		assert.Equal(t, 1<<23-1, s.Len())
+		assert.True(t, s.Contains(mac("00:00:5e:80:00:00")))
+		assert.False(t, s.Contains(mac("00:00:5e:7f:ff:ff")))
+		assert.False(t, s.Contains(mac("00:00:5e:ff:ff:ff")))
+	})
+	t.Run("ranges", func(t *testing.T) {
+		t.Parallel()
+		var b macaddr.MACSetBuilder
+		b.AddRange(macaddr.MustParseMACRange("00:00:5e:00:53:00-00:00:5e:00:53:ff"))
+		b.RemoveRange(macaddr.MustParseMACRange("00:00:5e:00:53:10-00:00:5e:00:53:1f"))
+		s, err := b.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"00:00:5e:00:53:00-00:00:5e:00:53:0f",
+			"00:00:5e:00:53:20-00:00:5e:00:53:ff",
+		}, rangeStrings(s.Ranges()))
+		assert.True(t, s.ContainsRange(macaddr.MustParseMACRange("00:00:5e:00:53:20-00:00:5e:00:53:ff")))
+		assert.False(t, s.ContainsRange(macaddr.MustParseMACRange("00:00:5e:00:53:0f-00:00:5e:00:53:20")))
+		assert.True(t, s.ContainsPrefix(prefix("00:00:5e:00:53:80/41")))
+		assert.False(t, s.ContainsPrefix(prefix("00:00:5e:00:53:00/40")))
+	})
+	t.Run("set algebra", func(t *testing.T) {
+		t.Parallel()
+		var a, b macaddr.MACSetBuilder
+		a.AddPrefix(prefix("00:00:5e:00:00:00/24"))
+		b.AddPrefix(prefix("00:00:5e:00:53:00/40"))
+		b.AddPrefix(prefix("00:00:5f:00:00:00/24"))
+		sa, _ := a.MACSet()
+		sb, _ := b.MACSet()
+
+		var union macaddr.MACSetBuilder
+		union.AddSet(sa)
+		union.AddSet(sb)
+		u, err := union.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"00:00:5e:00:00:00/23"}, prefixStrings(u.Prefixes()))
+
+		var inter macaddr.MACSetBuilder
+		inter.AddSet(sa)
+		inter.Intersect(sb)
+		i, err := inter.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"00:00:5e:00:53:00/40"}, prefixStrings(i.Prefixes()))
+
+		var diff macaddr.MACSetBuilder
+		diff.AddSet(sb)
+		diff.RemoveSet(sa)
+		d, err := diff.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"00:00:5f:00:00:00/24"}, prefixStrings(d.Prefixes()))
+
+		var none macaddr.MACSetBuilder
+		none.AddSet(sa)
+		none.Intersect(nil)
+		n, _ := none.MACSet()
+		assert.Zero(t, n.Len())
+	})
+	t.Run("Complement()", func(t *testing.T) {
+		t.Parallel()
+		var b macaddr.MACSetBuilder
+		b.AddPrefix(prefix("00:00:00:00:00:00/1"))
+		b.Add(mac("ff:ff:ff:ff:ff:ff"))
+		b.Complement()
+		s, err := b.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"80:00:00:00:00:00-ff:ff:ff:ff:ff:fe"}, rangeStrings(s.Ranges()))
+		b.Complement()
+		b.Complement()
+		b.AddPrefix(prefix("00:00:00:00:00:00/0"))
+		s, err = b.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, 1<<48, s.Len())
+		assert.Equal(t, []string{"00:00:00:00:00:00/0"}, prefixStrings(s.Prefixes()))
+		b.RemovePrefix(prefix("00:00:00:00:00:00/0"))
+		s, _ = b.MACSet()
+		assert.Empty(t, s.Ranges())
+	})
+	t.Run("immutable", func(t *testing.T) {
+		t.Parallel()
+		var b macaddr.MACSetBuilder
+		b.Add(mac("00:00:5e:00:53:ab"))
+		s, _ := b.MACSet()
+		b.Add(mac("00:00:5e:00:53:ac"))
+		assert.Equal(t, 1, s.Len())
+		s2, _ := b.MACSet()
+		assert.False(t, s.Equal(s2))
+		b.Remove(mac("00:00:5e:00:53:ac"))
+		s3, _ := b.MACSet()
+		assert.True(t, s.Equal(s3))
+	})
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		var b macaddr.MACSetBuilder
+		b.Add(nil)
+		b.AddPrefix(nil)
+		b.RemoveRange(&macaddr.MACRange{First: mac("00:00:5e:00:53:ff"), Last: mac("00:00:5e:00:53:00")})
+		b.Add(mac("00:00:5e:00:53:ab"))
+		s, err := b.MACSet()
+		assert.Error(t, err)
+		assert.Equal(t, 1, s.Len())
+	})
+	t.Run("order", func(t *testing.T) {
+		t.Parallel()
+		var b macaddr.MACSetBuilder
+		b.AddPrefix(prefix("00:00:5e:00:53:00/40"))
+		b.Remove(mac("00:00:5e:00:53:01"))
+		b.Remove(mac("00:00:5e:00:53:02"))
+		b.Add(mac("00:00:5e:00:53:02"))
+		b.RemovePrefix(prefix("00:00:5e:00:53:80/41"))
+		b.Add(mac("00:00:5e:00:53:ff"))
+		s, err := b.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"00:00:5e:00:53:00-00:00:5e:00:53:00",
+			"00:00:5e:00:53:02-00:00:5e:00:53:7f",
+			"00:00:5e:00:53:ff-00:00:5e:00:53:ff",
+		}, rangeStrings(s.Ranges()))
+	})
+	t.Run("host bits", func(t *testing.T) {
+		t.Parallel()
+		oui := prefix("00:00:5e:00:00:00/24")
+		var b macaddr.MACSetBuilder
+		b.AddPrefix(&macaddr.MACPrefix{MAC: mac("00:00:5e:12:34:56"), Mask: oui.Mask})
+		s, err := b.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"00:00:5e:00:00:00/24"}, prefixStrings(s.Prefixes()))
+		assert.True(t, s.ContainsPrefix(oui))
+	})
+	t.Run("many", func(t *testing.T) {
+		t.Parallel()
+		var b macaddr.MACSetBuilder
+		for i := uint64(0); i < 100_000; i++ {
+			// Every other address, in descending order.
+			b.Add(macaddr.AddrFromUint64(0x00005e000000 + 2*(100_000-i)).MACAddress())
+		}
+		s, err := b.MACSet()
+		require.NoError(t, err)
+		assert.Equal(t, 100_000, s.Len())
+		assert.Len(t, s.Ranges(), 100_000)
+	})
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+		var s macaddr.MACSet
+		assert.False(t, s.Contains(mac("00:00:5e:00:53:ab")))
+		assert.False(t, s.ContainsAddr(macaddr.Addr{}))
+		assert.Empty(t, s.Prefixes())
+		var n *macaddr.MACSet
+		assert.False(t, n.Contains(mac("00:00:5e:00:53:ab")))
+		assert.True(t, n.Equal(&s))
+	})
+}
+
+func Benchmark_MACSetBuilder_Add(b *testing.B) {
+	macs := make([]*macaddr.MACAddress, 100_000)
+	for i := range macs {
+		// Separate addresses in a scattered order, so no two are merged.
+		macs[i] = macaddr.AddrFromUint64(0x00005e000000 + 2*uint64(i*7919%len(macs))).MACAddress()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var sb macaddr.MACSetBuilder
+		for _, m := range macs {
+			sb.Add(m)
+		}
+		if _, err := sb.MACSet(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func ExampleMACSetBuilder() {
+	_, vendors := macaddr.MustParseMACPrefix("00:00:5e:00:00:00/24")
+	_, quarantined := macaddr.MustParseMACPrefix("00:00:5e:00:00:00/25")
+	var b macaddr.MACSetBuilder
+	b.AddPrefix(vendors)
+	b.RemovePrefix(quarantined)
+	set, err := b.MACSet()
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(set.Contains(macaddr.MustParseMACAddress("00:00:5e:00:53:ab")))
+	fmt.Println(set.Contains(macaddr.MustParseMACAddress("00:00:5e:80:53:ab")))
+	for _, p := range set.Prefixes() {
+		fmt.Println(p.String())
+	}
+	// Output:
+	// false
+	// true
+	// 00:00:5e:80:00:00/25
+}
@@ -1,6 +1,7 @@
 package macaddr_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -266,6 +267,74 @@ func Test_MACAddress(t *testing.T) {
 	})
 }
 
+func Test_MACAddress_Text(t *testing.T) {
+	type payload struct {
+		MAC    macaddr.MACAddress  `json:"mac"`
+		MACPtr *macaddr.MACAddress `json:"mac_ptr"`
+	}
+	t.Run("MarshalText()", func(t *testing.T) {
+		t.Parallel()
+		m := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+		b, err := m.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:53:ab", string(b))
+		b, err = macaddr.MACAddress{}.MarshalText()
+		require.NoError(t, err)
+		assert.Empty(t, b)
+		_, err = macaddr.MACAddress{0x01}.MarshalText()
+		require.Error(t, err)
+	})
+	t.Run("UnmarshalText()", func(t *testing.T) {
+		t.Parallel()
+		var m macaddr.MACAddress
+		require.NoError(t, m.UnmarshalText([]byte("0000.5e00.53ab")))
+		assert.Equal(t, "00:00:5e:00:53:ab", m.String())
+		require.NoError(t, m.UnmarshalText(nil))
+		assert.Nil(t, m)
+		require.Error(t, m.UnmarshalText([]byte("this should error")))
+	})
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+		in := payload{
+			MAC:    *macaddr.MustParseMACAddress("00:00:5e:00:53:ab"),
+			MACPtr: macaddr.MustParseMACAddress("00:00:5e:00:53:ac"),
+		}
+		b, err := json.Marshal(in)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"mac":"00:00:5e:00:53:ab","mac_ptr":"00:00:5e:00:53:ac"}`, string(b))
+		var out payload
+		require.NoError(t, json.Unmarshal(b, &out))
+		assert.Equal(t, in, out)
+		require.Error(t, json.Unmarshal([]byte(`{"mac":"nope"}`), &out))
+	})
+}
+
+func Test_MACAddress_Prefix(t *testing.T) {
+	m := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+	t.Run("works", func(t *testing.T) {
+		t.Parallel()
+		p, err := m.Prefix(28)
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:00:00/28", p.String())
+		p, err = m.Prefix(48)
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:53:ab/48", p.String())
+	})
+	t.Run("invalid length", func(t *testing.T) {
+		t.Parallel()
+		_, err := m.Prefix(49)
+		require.Error(t, err)
+		_, err = m.Prefix(-1)
+		require.Error(t, err)
+	})
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		var m *macaddr.MACAddress
+		_, err := m.Prefix(24)
+		require.Error(t, err)
+	})
+}
+
 func Test_MaskFromPrefixLen(t *testing.T) {
 	type pair struct {
 		m *macaddr.MACAddress
@@ -433,6 +502,17 @@ func ExampleMACAddress_OUI() {
 	// 00:00:5e
 }
 
+func ExampleMACAddress_Prefix() {
+	mac := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+	prefix, err := mac.Prefix(24)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(prefix)
+	// Output:
+	// 00:00:5e:00:00:00/24
+}
+
 func ExampleMACAddress_ByteString() {
 	mac := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
 	byteString := mac.ByteString()
@@ -0,0 +1,118 @@
+package macaddr
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// ErrNotMulticast is returned when an IP address is not a multicast group.
+var ErrNotMulticast = errors.New("not a multicast address")
+
+const (
+	// ipv4MulticastBits masks the low 23 bits of an IPv4 multicast group that are mapped to a
+	// MAC address, as described in RFC 1112.
+	ipv4MulticastBits uint32 = 1<<23 - 1
+	// ipv4MulticastGroups is the number of IPv4 multicast groups mapped to each MAC address.
+	ipv4MulticastGroups = 32
+	// groupsValid is set on every valid IPv4MulticastGroups, so the zero value is distinguishable
+	// from the groups mapped to 01:00:5e:00:00:00.
+	groupsValid uint32 = 1 << 31
+)
+
+// MulticastMAC returns the Ethernet multicast MACAddress of an IP multicast group. IPv4 groups
+// are mapped to 01:00:5e followed by the low 23 bits of the group (RFC 1112), and IPv6 groups are
+// mapped to 33:33 followed by the low 32 bits of the group (RFC 2464). ErrNotMulticast is
+// returned if the address is not a multicast group.
+func MulticastMAC(a netip.Addr) (*MACAddress, error) {
+	a = a.Unmap()
+	if !a.IsMulticast() {
+		return nil, fmt.Errorf("'%s' is %w", a, ErrNotMulticast)
+	}
+	if a.Is4() {
+		b := a.As4()
+		return FromBytes(0x01, 0x00, 0x5e, b[1]&0x7f, b[2], b[3]), nil
+	}
+	b := a.As16()
+	return FromBytes(0x33, 0x33, b[12], b[13], b[14], b[15]), nil
+}
+
+// IPv4MulticastGroups is the set of 32 IPv4 multicast groups that map to the same Ethernet
+// multicast MAC address. Because only the low 23 bits of a group are mapped, the groups differ
+// only in the low 4 bits of the first octet (224-239) and the high bit of the second octet. The
+// zero value contains no groups.
+type IPv4MulticastGroups struct {
+	v uint32
+}
+
+// IPv4MulticastGroups returns the set of IPv4 multicast groups that map to the MACAddress. If the
+// MACAddress is not within 01:00:5e:00:00:00/25, IPv4MulticastGroups returns false.
+func (m *MACAddress) IPv4MulticastGroups() (IPv4MulticastGroups, bool) {
+	if m == nil || len(*m) != constant.MacByteLen {
+		return IPv4MulticastGroups{}, false
+	}
+	b := *m
+	if b[0] != 0x01 || b[1] != 0x00 || b[2] != 0x5e || b[3]&0x80 != 0 {
+		return IPv4MulticastGroups{}, false
+	}
+	return IPv4MulticastGroups{v: groupsValid | uint32(b[3])<<16 | uint32(b[4])<<8 | uint32(b[5])}, true
+}
+
+// IsValid determines if the IPv4MulticastGroups contains any groups, i.e. it is not the zero
+// value.
+func (g IPv4MulticastGroups) IsValid() bool { return g.v&groupsValid != 0 }
+
+// MAC returns the Ethernet multicast MACAddress every group maps to.
+func (g IPv4MulticastGroups) MAC() *MACAddress {
+	if !g.IsValid() {
+		return nil
+	}
+	return FromBytes(0x01, 0x00, 0x5e, byte(g.v>>16)&0x7f, byte(g.v>>8), byte(g.v))
+}
+
+// Len returns the number of groups, which is 32 unless the IPv4MulticastGroups is the zero value.
+func (g IPv4MulticastGroups) Len() int {
+	if !g.IsValid() {
+		return 0
+	}
+	return ipv4MulticastGroups
+}
+
+// Groups returns every group, in ascending order.
+func (g IPv4MulticastGroups) Groups() []netip.Addr {
+	if !g.IsValid() {
+		return nil
+	}
+	groups := make([]netip.Addr, 0, ipv4MulticastGroups)
+	low := g.v & ipv4MulticastBits
+	for first := uint32(224); first <= 239; first++ {
+		for _, high := range []uint32{0, 1 << 23} {
+			v := first<<24 | high | low
+			groups = append(groups, netip.AddrFrom4([4]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}))
+		}
+	}
+	return groups
+}
+
+// Contains determines if an IPv4 multicast group is in the set, i.e. it maps to the same MAC
+// address.
+func (g IPv4MulticastGroups) Contains(a netip.Addr) bool {
+	a = a.Unmap()
+	if !g.IsValid() || !a.Is4() || !a.IsMulticast() {
+		return false
+	}
+	b := a.As4()
+	return (uint32(b[1])<<16|uint32(b[2])<<8|uint32(b[3]))&ipv4MulticastBits == g.v&ipv4MulticastBits
+}
+
+// String returns a compact representation of the groups, e.g. '{224-239}.{0,128}.0.251' for the
+// groups mapped to 01:00:5e:00:00:fb.
+func (g IPv4MulticastGroups) String() string {
+	if !g.IsValid() {
+		return constant.NilStr
+	}
+	second := byte(g.v>>16) & 0x7f
+	return fmt.Sprintf("{224-239}.{%d,%d}.%d.%d", second, second|0x80, byte(g.v>>8), byte(g.v))
+}
@@ -0,0 +1,112 @@
+package macaddr_test
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_MulticastMAC(t *testing.T) {
+	type pair struct {
+		ip  string
+		mac string
+	}
+	tests := []pair{
+		{"224.0.0.251", "01:00:5e:00:00:fb"},
+		{"239.128.0.251", "01:00:5e:00:00:fb"},
+		{"233.252.18.1", "01:00:5e:7c:12:01"},
+		{"::ffff:224.0.0.1", "01:00:5e:00:00:01"},
+		{"ff02::1", "33:33:00:00:00:01"},
+		{"ff02::1:ff00:53ab", "33:33:ff:00:53:ab"},
+	}
+	for _, p := range tests {
+		p := p
+		t.Run(p.ip, func(t *testing.T) {
+			t.Parallel()
+			mac, err := macaddr.MulticastMAC(netip.MustParseAddr(p.ip))
+			require.NoError(t, err)
+			assert.Equal(t, p.mac, mac.String())
+			assert.True(t, mac.IsMulticast())
+		})
+	}
+	t.Run("not multicast", func(t *testing.T) {
+		t.Parallel()
+		for _, a := range []netip.Addr{netip.MustParseAddr("192.0.2.1"), netip.MustParseAddr("2001:db8::1"), {}} {
+			_, err := macaddr.MulticastMAC(a)
+			assert.True(t, errors.Is(err, macaddr.ErrNotMulticast), a)
+		}
+	})
+}
+
+func Test_IPv4MulticastGroups(t *testing.T) {
+	mac := macaddr.MustParseMACAddress("01:00:5e:00:00:fb")
+	g, ok := mac.IPv4MulticastGroups()
+	require.True(t, ok)
+	t.Run("Groups()", func(t *testing.T) {
+		t.Parallel()
+		groups := g.Groups()
+		assert.Len(t, groups, 32)
+		assert.Equal(t, 32, g.Len())
+		assert.Equal(t, "224.0.0.251", groups[0].String())
+		assert.Equal(t, "224.128.0.251", groups[1].String())
+		assert.Equal(t, "239.128.0.251", groups[31].String())
+		for _, a := range groups {
+			m, err := macaddr.MulticastMAC(a)
+			require.NoError(t, err)
+			assert.True(t, mac.Equal(m), a)
+		}
+	})
+	t.Run("Contains()", func(t *testing.T) {
+		t.Parallel()
+		assert.True(t, g.Contains(netip.MustParseAddr("232.128.0.251")))
+		assert.True(t, g.Contains(netip.MustParseAddr("::ffff:224.0.0.251")))
+		assert.False(t, g.Contains(netip.MustParseAddr("224.0.1.251")))
+		assert.False(t, g.Contains(netip.MustParseAddr("192.0.0.251")))
+		assert.False(t, g.Contains(netip.MustParseAddr("ff02::fb")))
+	})
+	t.Run("MAC() and String()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, "01:00:5e:00:00:fb", g.MAC().String())
+		assert.Equal(t, "{224-239}.{0,128}.0.251", g.String())
+	})
+	t.Run("not IPv4 multicast", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range []string{"01:00:5e:80:00:fb", "33:33:00:00:00:fb", "00:00:5e:00:53:ab"} {
+			_, ok := macaddr.MustParseMACAddress(s).IPv4MulticastGroups()
+			assert.False(t, ok, s)
+		}
+		var m *macaddr.MACAddress
+		_, ok := m.IPv4MulticastGroups()
+		assert.False(t, ok)
+	})
+	t.Run("zero", func(t *testing.T) {
+		t.Parallel()
+		var z macaddr.IPv4MulticastGroups
+		assert.False(t, z.IsValid())
+		assert.Zero(t, z.Len())
+		assert.Nil(t, z.Groups())
+		assert.Nil(t, z.MAC())
+		assert.False(t, z.Contains(netip.MustParseAddr("224.0.0.0")))
+		assert.Equal(t, "<nil>", z.String())
+		g, ok := macaddr.MustParseMACAddress("01:00:5e:00:00:00").IPv4MulticastGroups()
+		assert.True(t, ok)
+		assert.True(t, g.Contains(netip.MustParseAddr("224.0.0.0")))
+	})
+}
+
+func ExampleMACAddress_IPv4MulticastGroups() {
+	mac, _ := macaddr.MulticastMAC(netip.MustParseAddr("239.1.1.1"))
+	fmt.Println(mac.String())
+	groups, _ := mac.IPv4MulticastGroups()
+	fmt.Println(groups.String())
+	fmt.Println(groups.Contains(netip.MustParseAddr("224.129.1.1")))
+	// Output:
+	// 01:00:5e:01:01:01
+	// {224-239}.{1,129}.1.1
+	// true
+}
@@ -0,0 +1,268 @@
+package macaddr
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+	"go.mdl.wtf/go-macaddr/internal/format"
+)
+
+// Notation is a set of MAC Address notations, used to restrict the input accepted by
+// ParseOptions.
+type Notation uint
+
+const (
+	// NotationColon is colon-separated octets, e.g. 'xx:xx:xx:xx:xx:xx'.
+	NotationColon Notation = 1 << iota
+	// NotationDash is dash-separated octets, e.g. 'xx-xx-xx-xx-xx-xx'.
+	NotationDash
+	// NotationDot is dot-separated groups of 4 digits, e.g. 'xxxx.xxxx.xxxx'.
+	NotationDot
+	// NotationBare is digits with no separators, e.g. 'xxxxxxxxxxxx'.
+	NotationBare
+	// NotationAll is every notation.
+	NotationAll = NotationColon | NotationDash | NotationDot | NotationBare
+)
+
+// ParseOptions controls how input is parsed. The zero value is strict: only complete,
+// well-formed addresses in any notation are accepted.
+//
+// ParseMACAddress predates ParseOptions and, for compatibility, silently right-pads short input
+// with zeros. Prefer ParseOptions when input is untrusted.
+type ParseOptions struct {
+	// Lenient accepts loosely formatted input: surrounding whitespace and quotes, '0x' prefixes,
+	// mixed separators, and groups without leading zeros, e.g. '0:0:5e:0:53:ab' or
+	// '00005E-0053AB'. Groups are interpreted by width: if every group has at most 2 digits,
+	// each group is an octet; otherwise 3 groups are 16-bit groups and 2 groups are 24-bit
+	// halves. Each group is left-padded with zeros to its full width.
+	Lenient bool
+	// AllowPadding accepts input with fewer than 6 octets (12 digits), which is right-padded with
+	// zeros, e.g. '01:23:45' becomes 01:23:45:00:00:00. Without AllowPadding, such input returns
+	// an error matching ErrInvalidLength.
+	AllowPadding bool
+	// Notations restricts strict parsing to a set of notations. If zero, all notations are
+	// accepted. Notations is ignored when Lenient is set.
+	Notations Notation
+}
+
+var (
+	// StrictParseOptions accepts only complete, well-formed addresses in any notation.
+	StrictParseOptions = ParseOptions{}
+	// LenientParseOptions accepts loosely formatted, but complete, addresses.
+	LenientParseOptions = ParseOptions{Lenient: true}
+)
+
+// group is a run of digits within parser input, and its byte offset in the input.
+type group struct {
+	s   string
+	off int
+}
+
+// ParseMACAddress parses an input string to a valid MACAddress object according to the options.
+// All errors are of type *ParseError.
+func (o ParseOptions) ParseMACAddress(s string) (*MACAddress, error) {
+	var h string
+	var err error
+	if o.Lenient {
+		h, err = o.lenient(s)
+	} else {
+		h, err = o.strict(s)
+	}
+	if err != nil {
+		return nil, err
+	}
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return nil, errInvalidFormat(s, err)
+	}
+	return FromByteArray(b), nil
+}
+
+// ParseAddr operates identically to ParseMACAddress, but returns an Addr.
+func (o ParseOptions) ParseAddr(s string) (Addr, error) {
+	mac, err := o.ParseMACAddress(s)
+	if err != nil {
+		return Addr{}, err
+	}
+	return mac.Addr(), nil
+}
+
+// strict validates input in a single notation, returning its digits.
+func (o ParseOptions) strict(s string) (string, error) {
+	var sep byte
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == ':' || c == '-' || c == '.':
+			if sep == 0 {
+				sep = c
+			} else if c != sep {
+				return "", &ParseError{Kind: ErrorInvalidFormat, Input: s, Offset: i}
+			}
+		case !isHexDigit(c):
+			return "", errInvalidCharacter(s, i)
+		}
+	}
+	n, width := NotationBare, constant.HexStrLen
+	switch sep {
+	case ':':
+		n, width = NotationColon, 2
+	case '-':
+		n, width = NotationDash, 2
+	case '.':
+		n, width = NotationDot, 4
+	}
+	if o.Notations != 0 && o.Notations&n == 0 {
+		return "", &ParseError{Kind: ErrorInvalidFormat, Input: s, Offset: -1}
+	}
+	if sep == 0 {
+		return o.pad(s, s)
+	}
+	groups := splitGroups(s, string(sep))
+	for _, g := range groups {
+		if len(g.s) != width {
+			return "", &ParseError{Kind: ErrorInvalidFormat, Input: s, Offset: g.off}
+		}
+	}
+	return o.pad(s, joinGroups(groups))
+}
+
+// lenient normalizes loosely formatted input, returning its digits.
+func (o ParseOptions) lenient(s string) (string, error) {
+	start, end := 0, len(s)
+	for start < end && isSpace(s[start]) {
+		start++
+	}
+	for end > start && isSpace(s[end-1]) {
+		end--
+	}
+	if end-start >= 2 && strings.IndexByte(`"'`+"`", s[start]) >= 0 && s[end-1] == s[start] {
+		start++
+		end--
+		for start < end && isSpace(s[start]) {
+			start++
+		}
+		for end > start && isSpace(s[end-1]) {
+			end--
+		}
+	}
+
+	var groups []group
+	for i := start; i < end; {
+		if isSpace(s[i]) || isSeparator(s[i]) {
+			j, seps := i, 0
+			for ; j < end && (isSpace(s[j]) || isSeparator(s[j])); j++ {
+				if isSeparator(s[j]) {
+					seps++
+				}
+				if seps > 1 {
+					return "", &ParseError{Kind: ErrorInvalidFormat, Input: s, Offset: j}
+				}
+			}
+			if i == start || j == end {
+				return "", &ParseError{Kind: ErrorInvalidFormat, Input: s, Offset: i}
+			}
+			i = j
+			continue
+		}
+		if i+1 < end && s[i] == '0' && (s[i+1] == 'x' || s[i+1] == 'X') {
+			i += 2
+		}
+		j := i
+		for ; j < end && !isSpace(s[j]) && !isSeparator(s[j]); j++ {
+			if !isHexDigit(s[j]) {
+				return "", errInvalidCharacter(s, j)
+			}
+		}
+		if j == i {
+			return "", &ParseError{Kind: ErrorInvalidFormat, Input: s, Offset: i}
+		}
+		groups = append(groups, group{s: s[i:j], off: i})
+		i = j
+	}
+
+	switch {
+	case len(groups) == 0:
+		return o.pad(s, "")
+	case len(groups) == 1:
+		return o.pad(s, groups[0].s)
+	case maxGroupLen(groups) <= 2:
+		if len(groups) > constant.MacByteLen {
+			return "", errInvalidLength(&LengthError{Input: s, Len: len(groups), Want: constant.MacByteLen})
+		}
+		return o.pad(s, joinGroups(leftPad(groups, 2)))
+	case len(groups) == 3 && maxGroupLen(groups) <= 4:
+		return joinGroups(leftPad(groups, 4)), nil
+	case len(groups) == 2 && maxGroupLen(groups) <= 6:
+		return joinGroups(leftPad(groups, 6)), nil
+	}
+	for _, g := range groups {
+		if len(g.s) > 2 {
+			return "", &ParseError{Kind: ErrorInvalidFormat, Input: s, Offset: g.off}
+		}
+	}
+	return "", &ParseError{Kind: ErrorInvalidFormat, Input: s, Offset: -1}
+}
+
+// pad right-pads digits h to a complete address if AllowPadding is set, or returns an error if
+// h is not a complete address.
+func (o ParseOptions) pad(s, h string) (string, error) {
+	switch {
+	case len(h) > constant.HexStrLen:
+		return "", errInvalidLength(&LengthError{Input: s, Kind: KindFromLen(len(h) / 2), Len: (len(h) + 1) / 2, Want: constant.MacByteLen})
+	case len(h) == constant.HexStrLen:
+		return h, nil
+	case o.AllowPadding && len(h) > 0:
+		return format.PadRight(h, "0", constant.HexStrLen), nil
+	}
+	return "", errInvalidLength(&LengthError{Input: s, Len: (len(h) + 1) / 2, Want: constant.MacByteLen})
+}
+
+// splitGroups splits s on sep, recording the offset of each group.
+func splitGroups(s, sep string) []group {
+	var groups []group
+	off := 0
+	for _, p := range strings.Split(s, sep) {
+		groups = append(groups, group{s: p, off: off})
+		off += len(p) + len(sep)
+	}
+	return groups
+}
+
+// joinGroups concatenates the digits of each group.
+func joinGroups(groups []group) string {
+	var b strings.Builder
+	for _, g := range groups {
+		b.WriteString(g.s)
+	}
+	return b.String()
+}
+
+// leftPad left-pads each group with zeros to width n.
+func leftPad(groups []group, n int) []group {
+	padded := make([]group, len(groups))
+	for i, g := range groups {
+		padded[i] = group{s: strings.Repeat("0", n-len(g.s)) + g.s, off: g.off}
+	}
+	return padded
+}
+
+// maxGroupLen returns the length of the longest group.
+func maxGroupLen(groups []group) int {
+	n := 0
+	for _, g := range groups {
+		if len(g.s) > n {
+			n = len(g.s)
+		}
+	}
+	return n
+}
+
+func isHexDigit(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'f') || ('A' <= c && c <= 'F')
+}
+
+func isSeparator(c byte) bool { return c == ':' || c == '-' || c == '.' }
+
+func isSpace(c byte) bool { return c == ' ' || c == '\t' || c == '\n' || c == '\r' }
@@ -0,0 +1,173 @@
+package macaddr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_ParseOptions_Strict(t *testing.T) {
+	o := macaddr.StrictParseOptions
+	valid := []string{
+		"00:00:5e:00:53:ab",
+		"00-00-5E-00-53-AB",
+		"0000.5e00.53ab",
+		"00005e0053ab",
+	}
+	for i, s := range valid {
+		s := s
+		t.Run(fmt.Sprintf("valid %d", i+1), func(t *testing.T) {
+			t.Parallel()
+			m, err := o.ParseMACAddress(s)
+			require.NoError(t, err)
+			assert.Equal(t, "00:00:5e:00:53:ab", m.String())
+		})
+	}
+	type invalid struct {
+		s        string
+		sentinel error
+		offset   int
+	}
+	errs := []invalid{
+		{"0123", macaddr.ErrInvalidLength, -1},
+		{"01:23:45", macaddr.ErrInvalidLength, -1},
+		{"", macaddr.ErrInvalidLength, -1},
+		{"0:0:5e:0:53:ab", macaddr.ErrInvalidFormat, 0},
+		{"00:00:5e-00:53:ab", macaddr.ErrInvalidFormat, 8},
+		{"00:00:5e:00:53:ag", macaddr.ErrInvalidCharacter, 16},
+		{" 00:00:5e:00:53:ab", macaddr.ErrInvalidCharacter, 0},
+		{"00:00:5e:00:53:ab:cd", macaddr.ErrInvalidLength, -1},
+		{"0000.5e00.53ab.cdef", macaddr.ErrInvalidLength, -1},
+		{"00005e0053abcd", macaddr.ErrInvalidLength, -1},
+	}
+	for i, e := range errs {
+		e := e
+		t.Run(fmt.Sprintf("invalid %d", i+1), func(t *testing.T) {
+			t.Parallel()
+			_, err := o.ParseMACAddress(e.s)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, e.sentinel), err.Error())
+			var pe *macaddr.ParseError
+			require.True(t, errors.As(err, &pe))
+			assert.Equal(t, e.offset, pe.Offset)
+		})
+	}
+	t.Run("Notations", func(t *testing.T) {
+		t.Parallel()
+		o := macaddr.ParseOptions{Notations: macaddr.NotationColon | macaddr.NotationDot}
+		_, err := o.ParseMACAddress("00:00:5e:00:53:ab")
+		require.NoError(t, err)
+		_, err = o.ParseMACAddress("0000.5e00.53ab")
+		require.NoError(t, err)
+		_, err = o.ParseMACAddress("00-00-5e-00-53-ab")
+		assert.True(t, errors.Is(err, macaddr.ErrInvalidFormat))
+		_, err = o.ParseMACAddress("00005e0053ab")
+		assert.True(t, errors.Is(err, macaddr.ErrInvalidFormat))
+	})
+	t.Run("AllowPadding", func(t *testing.T) {
+		t.Parallel()
+		o := macaddr.ParseOptions{AllowPadding: true}
+		m, err := o.ParseMACAddress("01:23:45")
+		require.NoError(t, err)
+		assert.Equal(t, "01:23:45:00:00:00", m.String())
+		m, err = o.ParseMACAddress("0123")
+		require.NoError(t, err)
+		assert.Equal(t, "01:23:00:00:00:00", m.String())
+		_, err = o.ParseMACAddress("")
+		assert.True(t, errors.Is(err, macaddr.ErrInvalidLength))
+	})
+}
+
+func Test_ParseOptions_Lenient(t *testing.T) {
+	o := macaddr.LenientParseOptions
+	valid := []string{
+		"00:00:5e:00:53:ab",
+		"0:0:5e:0:53:ab",
+		"0-0-5E-0-53-AB",
+		"00005E-0053AB",
+		"0:5e00:53ab",
+		"0000.5e00.53ab",
+		"  00:00:5e:00:53:ab\n",
+		`"00:00:5e:00:53:ab"`,
+		"' 00-00-5e-00-53-ab '",
+		"0x00005e0053ab",
+		"0x00:0x00:0x5e:0x00:0x53:0xab",
+		"00:00-5e.00 53:ab",
+		"00 : 00 : 5e : 00 : 53 : ab",
+		"00 00 5e 00 53 ab",
+	}
+	for i, s := range valid {
+		s := s
+		t.Run(fmt.Sprintf("valid %d", i+1), func(t *testing.T) {
+			t.Parallel()
+			m, err := o.ParseMACAddress(s)
+			require.NoError(t, err)
+			assert.Equal(t, "00:00:5e:00:53:ab", m.String())
+		})
+	}
+	type invalid struct {
+		s        string
+		sentinel error
+		offset   int
+	}
+	errs := []invalid{
+		{"0123", macaddr.ErrInvalidLength, -1},
+		{"0:5e:0", macaddr.ErrInvalidLength, -1},
+		{"", macaddr.ErrInvalidLength, -1},
+		{"00::5e:00:53:ab", macaddr.ErrInvalidFormat, 3},
+		{":00:00:5e:00:53:ab", macaddr.ErrInvalidFormat, 0},
+		{"00:00:5e:00:53:ab:", macaddr.ErrInvalidFormat, 17},
+		{"00:00:5e:00:53:ag", macaddr.ErrInvalidCharacter, 16},
+		{"00:00:5e:00:53:0x", macaddr.ErrInvalidFormat, 17},
+		{"0:0:5e:0:53:ab:1", macaddr.ErrInvalidLength, -1},
+		{"0000:5e00:53ab:0", macaddr.ErrInvalidFormat, 0},
+		{"00005e0053ab0000", macaddr.ErrInvalidLength, -1},
+	}
+	for i, e := range errs {
+		e := e
+		t.Run(fmt.Sprintf("invalid %d", i+1), func(t *testing.T) {
+			t.Parallel()
+			_, err := o.ParseMACAddress(e.s)
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, e.sentinel), err.Error())
+			var pe *macaddr.ParseError
+			require.True(t, errors.As(err, &pe))
+			assert.Equal(t, e.offset, pe.Offset)
+		})
+	}
+	t.Run("AllowPadding", func(t *testing.T) {
+		t.Parallel()
+		o := macaddr.ParseOptions{Lenient: true, AllowPadding: true}
+		m, err := o.ParseMACAddress(" 1:23:45 ")
+		require.NoError(t, err)
+		assert.Equal(t, "01:23:45:00:00:00", m.String())
+	})
+	t.Run("ParseAddr()", func(t *testing.T) {
+		t.Parallel()
+		a, err := o.ParseAddr("0:0:5e:0:53:ab")
+		require.NoError(t, err)
+		assert.Equal(t, macaddr.MustParseAddr("00:00:5e:00:53:ab"), a)
+		_, err = o.ParseAddr("nope")
+		require.Error(t, err)
+	})
+}
+
+func ExampleParseOptions() {
+	lenient := macaddr.ParseOptions{Lenient: true}
+	mac, err := lenient.ParseMACAddress(`"0:0:5e:0:53:ab"`)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(mac)
+
+	strict := macaddr.ParseOptions{Notations: macaddr.NotationColon}
+	_, err = strict.ParseMACAddress("01:23")
+	fmt.Println(errors.Is(err, macaddr.ErrInvalidLength))
+	// Output:
+	// 00:00:5e:00:53:ab
+	// true
+}
@@ -0,0 +1,50 @@
+package macaddr
+
+import (
+	"sort"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// prefixTable is a static table of entries, each describing a MACPrefix, that is searched for the
+// most specific entry containing an address. Entries are shared by every caller, so the
+// functions exporting them document that they must not be modified.
+type prefixTable[T any] struct {
+	entries []T
+	prefix  func(T) *MACPrefix
+}
+
+// newPrefixTable creates a prefixTable of entries, sorting them by prefix length, longest first.
+// Entries with the same prefix length keep their order.
+func newPrefixTable[T any](entries []T, prefix func(T) *MACPrefix) *prefixTable[T] {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return prefix(entries[i]).PrefixLen() > prefix(entries[j]).PrefixLen()
+	})
+	return &prefixTable[T]{entries: entries, prefix: prefix}
+}
+
+// all returns a copy of the entries of the prefixTable, sorted by prefix length, longest first.
+func (t *prefixTable[T]) all() []T {
+	all := make([]T, len(t.entries))
+	copy(all, t.entries)
+	return all
+}
+
+// lookup returns the most specific entry containing mac. If no entry contains mac, ok is false.
+func (t *prefixTable[T]) lookup(mac *MACAddress) (e T, ok bool) {
+	if mac == nil || len(*mac) != constant.MacByteLen {
+		return e, false
+	}
+	for _, e := range t.entries {
+		if t.prefix(e).Contains(mac) {
+			return e, true
+		}
+	}
+	return e, false
+}
+
+// mustPrefix parses a MACPrefix for a prefixTable entry, panicking if it is invalid.
+func mustPrefix(s string) *MACPrefix {
+	_, p := MustParseMACPrefix(s)
+	return p
+}
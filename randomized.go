@@ -0,0 +1,202 @@
+package macaddr
+
+import (
+	"fmt"
+	"strings"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// Randomization is a verdict on whether a MACAddress is randomized, i.e. a private address
+// generated by the operating system rather than the address burned in to the network interface.
+type Randomization int
+
+const (
+	// RandomizationUnlikely is an address that is probably burned in, or assigned by a known
+	// platform or protocol.
+	RandomizationUnlikely Randomization = iota
+	// RandomizationPossible is an address that may be randomized.
+	RandomizationPossible
+	// RandomizationLikely is an address that is probably randomized.
+	RandomizationLikely
+)
+
+// String returns the name of the Randomization verdict, e.g. 'likely'.
+func (r Randomization) String() string {
+	switch r {
+	case RandomizationPossible:
+		return "possible"
+	case RandomizationLikely:
+		return "likely"
+	}
+	return "unlikely"
+}
+
+const (
+	// randomizationLikely is the minimum score of a RandomizationLikely verdict.
+	randomizationLikely = 0.7
+	// randomizationPossible is the minimum score of a RandomizationPossible verdict.
+	randomizationPossible = 0.4
+)
+
+// RandomizationReport is the result of analyzing one or more MAC addresses for randomization.
+type RandomizationReport struct {
+	// Verdict is the verdict derived from Score.
+	Verdict Randomization
+	// Score is the likelihood the address is randomized, from 0 to 1.
+	Score float64
+	// Reasons describes each factor that contributed to Score.
+	Reasons []string
+}
+
+// String returns a human-readable representation of the RandomizationReport.
+func (r *RandomizationReport) String() string {
+	if r == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s (%.2f): %s", r.Verdict, r.Score, strings.Join(r.Reasons, "; "))
+}
+
+// add adjusts the score of the RandomizationReport by w, recording the reason.
+func (r *RandomizationReport) add(w float64, reason string) {
+	r.Score += w
+	r.Reasons = append(r.Reasons, reason)
+}
+
+// finish clamps the score of the RandomizationReport and sets its verdict.
+func (r *RandomizationReport) finish() *RandomizationReport {
+	switch {
+	case r.Score < 0:
+		r.Score = 0
+	case r.Score > 1:
+		r.Score = 1
+	}
+	switch {
+	case r.Score >= randomizationLikely:
+		r.Verdict = RandomizationLikely
+	case r.Score >= randomizationPossible:
+		r.Verdict = RandomizationPossible
+	default:
+		r.Verdict = RandomizationUnlikely
+	}
+	return r
+}
+
+// randomizationPattern is a range of locally administered addresses known to be used for
+// randomized addresses.
+type randomizationPattern struct {
+	prefix *MACPrefix
+	desc   string
+}
+
+// randomizationPatterns is the table of known randomization patterns.
+var randomizationPatterns = newPrefixTable([]*randomizationPattern{
+	{mustPrefix("da:a1:19:00:00:00/24"), "Android scan randomization (Google CID)"},
+}, func(p *randomizationPattern) *MACPrefix { return p.prefix })
+
+// RandomizationAnalyzer analyzes MAC addresses for randomization. Operating systems generate
+// randomized addresses as locally administered unicast addresses, usually with the remaining bits
+// random, so the universal/local bit is the strongest signal. Ranges known to be used for
+// randomized addresses, the SLAP quadrant, known platform and protocol ranges and, if Vendor is
+// set, whether the OUI is assigned adjust the score. The zero value is ready to use.
+type RandomizationAnalyzer struct {
+	// Vendor returns the organization an address is assigned to, or an empty string if it is
+	// unassigned, e.g. (*MACAddress).Vendor or a lookup in a registry.Registry loaded from the
+	// current IEEE exports. If nil, assignment is not considered. An unassigned OUI is only a weak
+	// signal, since the registry may be out of date.
+	Vendor func(*MACAddress) string
+}
+
+// Analyze analyzes a MACAddress for randomization.
+func (a *RandomizationAnalyzer) Analyze(m *MACAddress) *RandomizationReport {
+	r := &RandomizationReport{}
+	switch {
+	case m == nil || len(*m) != constant.MacByteLen || m.IsZero():
+		r.add(0, "not a valid address")
+		return r.finish()
+	case m.IsMulticast():
+		r.add(0, "group address")
+		return r.finish()
+	case m.IsUniversal():
+		r.add(0, "universally administered")
+		if a.Vendor != nil && a.Vendor(m) == "" {
+			r.add(0.1, "OUI not assigned")
+		}
+		return r.finish()
+	}
+	r.add(0.5, "locally administered")
+	if p := LookupPlatform(m); p != nil {
+		r.add(-0.5, fmt.Sprintf("assigned by %s", p.Platform))
+		return r.finish()
+	}
+	if f, ok := m.FHRP(); ok {
+		r.add(-0.5, fmt.Sprintf("%s virtual address", f.Protocol))
+		return r.finish()
+	}
+	// A known pattern takes precedence over the SLAP quadrant, as randomization schemes that use
+	// a CID place addresses in the ELI quadrant.
+	if p, ok := randomizationPatterns.lookup(m); ok {
+		r.add(0.4, fmt.Sprintf("known randomization pattern: %s", p.desc))
+		return r.finish()
+	}
+	switch q := m.SLAPQuadrant(); q {
+	case SLAPAAI:
+		r.add(0.3, "SLAP AAI quadrant, used for randomized addresses")
+	case SLAPELI:
+		r.add(-0.1, "SLAP ELI quadrant, assigned from a CID")
+	case SLAPSAI:
+		r.add(-0.1, "SLAP SAI quadrant, assigned by a standard protocol")
+	case SLAPReserved:
+		r.add(0.1, "SLAP Reserved quadrant")
+	}
+	if a.Vendor != nil && a.Vendor(m.SetLocal(false)) == "" {
+		r.add(0.1, "OUI not assigned")
+	}
+	return r.finish()
+}
+
+// AnalyzeSightings analyzes a sequence of addresses sighted for the same device or session, e.g.
+// the addresses a device used when probing for a network. Devices that rotate between distinct
+// locally administered addresses are more likely to use randomized addresses, and devices that
+// use a single universally administered address are less likely to. The score is the mean score
+// of each sighting, adjusted for rotation.
+func (a *RandomizationAnalyzer) AnalyzeSightings(sightings ...*MACAddress) *RandomizationReport {
+	r := &RandomizationReport{}
+	if len(sightings) == 0 {
+		r.add(0, "no sightings")
+		return r.finish()
+	}
+	local := map[Addr]struct{}{}
+	universal := map[Addr]struct{}{}
+	for _, s := range sightings {
+		sr := a.Analyze(s)
+		r.Score += sr.Score / float64(len(sightings))
+		switch {
+		case s == nil || s.IsMulticast():
+		case s.IsLocal():
+			local[s.Addr()] = struct{}{}
+		default:
+			universal[s.Addr()] = struct{}{}
+		}
+	}
+	r.Reasons = append(r.Reasons, fmt.Sprintf("mean score of %d sightings", len(sightings)))
+	switch {
+	case len(local) > 1:
+		r.add(0.2, fmt.Sprintf("rotated between %d locally administered addresses", len(local)))
+	case len(local) == 0 && len(universal) == 1:
+		r.add(-0.1, "single universally administered address")
+	}
+	return r.finish()
+}
+
+// Randomization analyzes the MACAddress for randomization with a zero RandomizationAnalyzer, which
+// does not consider whether the OUI is assigned.
+func (m *MACAddress) Randomization() *RandomizationReport {
+	return (&RandomizationAnalyzer{}).Analyze(m)
+}
+
+// AnalyzeSightings analyzes a sequence of addresses sighted for the same device or session with a
+// zero RandomizationAnalyzer. See RandomizationAnalyzer.AnalyzeSightings.
+func AnalyzeSightings(sightings ...*MACAddress) *RandomizationReport {
+	return (&RandomizationAnalyzer{}).AnalyzeSightings(sightings...)
+}
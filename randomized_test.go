@@ -0,0 +1,139 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_MACAddress_Randomization(t *testing.T) {
+	type pair struct {
+		mac     string
+		verdict macaddr.Randomization
+	}
+	tests := []pair{
+		{"00:00:0c:12:34:56", macaddr.RandomizationUnlikely},
+		{"00:00:5e:00:53:ab", macaddr.RandomizationUnlikely},
+		{"01:00:5e:00:00:fb", macaddr.RandomizationUnlikely},
+		{"52:54:00:12:34:56", macaddr.RandomizationUnlikely},
+		{"02:42:ac:11:00:02", macaddr.RandomizationUnlikely},
+		{"da:a1:19:12:34:56", macaddr.RandomizationLikely},
+		{"da:a1:18:12:34:56", macaddr.RandomizationPossible},
+		{"ae:12:34:56:78:9a", macaddr.RandomizationPossible},
+		{"06:12:34:56:78:9a", macaddr.RandomizationPossible},
+		{"f2:12:34:56:78:9a", macaddr.RandomizationLikely},
+		{"3e:12:34:56:78:9a", macaddr.RandomizationPossible},
+	}
+	for _, p := range tests {
+		p := p
+		t.Run(p.mac, func(t *testing.T) {
+			t.Parallel()
+			r := macaddr.MustParseMACAddress(p.mac).Randomization()
+			assert.Equal(t, p.verdict, r.Verdict, r.String())
+			assert.NotEmpty(t, r.Reasons)
+			assert.GreaterOrEqual(t, r.Score, 0.0)
+			assert.LessOrEqual(t, r.Score, 1.0)
+		})
+	}
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		var m *macaddr.MACAddress
+		assert.Equal(t, macaddr.RandomizationUnlikely, m.Randomization().Verdict)
+		for _, m := range []*macaddr.MACAddress{{}, {0x02, 0x00}} {
+			r := m.Randomization()
+			assert.Equal(t, macaddr.RandomizationUnlikely, r.Verdict, r.String())
+			assert.Equal(t, []string{"not a valid address"}, r.Reasons)
+		}
+		var r *macaddr.RandomizationReport
+		assert.Equal(t, "<nil>", r.String())
+	})
+}
+
+func Test_RandomizationAnalyzer(t *testing.T) {
+	t.Run("Vendor", func(t *testing.T) {
+		t.Parallel()
+		a := &macaddr.RandomizationAnalyzer{Vendor: (*macaddr.MACAddress).Vendor}
+		r := a.Analyze(macaddr.MustParseMACAddress("06:12:34:56:78:9a"))
+		assert.Equal(t, macaddr.RandomizationLikely, r.Verdict, r.String())
+		assert.Contains(t, r.Reasons, "OUI not assigned")
+		r = a.Analyze(macaddr.MustParseMACAddress("3e:22:fb:56:78:9a"))
+		assert.Equal(t, macaddr.RandomizationPossible, r.Verdict, r.String())
+		assert.NotContains(t, r.Reasons, "OUI not assigned")
+		r = a.Analyze(macaddr.MustParseMACAddress("00:00:0c:12:34:56"))
+		assert.Zero(t, r.Score, r.String())
+	})
+	t.Run("custom Vendor", func(t *testing.T) {
+		t.Parallel()
+		var looked []string
+		a := &macaddr.RandomizationAnalyzer{Vendor: func(m *macaddr.MACAddress) string {
+			looked = append(looked, m.String())
+			return "Example"
+		}}
+		r := a.Analyze(macaddr.MustParseMACAddress("06:12:34:56:78:9a"))
+		assert.Equal(t, macaddr.RandomizationPossible, r.Verdict, r.String())
+		assert.Equal(t, []string{"04:12:34:56:78:9a"}, looked)
+	})
+	t.Run("AnalyzeSightings()", func(t *testing.T) {
+		t.Parallel()
+		a := &macaddr.RandomizationAnalyzer{Vendor: (*macaddr.MACAddress).Vendor}
+		r := a.AnalyzeSightings(
+			macaddr.MustParseMACAddress("da:a1:19:12:34:56"),
+			macaddr.MustParseMACAddress("3e:9b:40:01:02:03"),
+			macaddr.MustParseMACAddress("76:0f:8a:aa:bb:cc"),
+		)
+		assert.Equal(t, macaddr.RandomizationLikely, r.Verdict, r.String())
+	})
+}
+
+func Test_MACAddress_Randomization_Pattern(t *testing.T) {
+	r := macaddr.MustParseMACAddress("da:a1:19:12:34:56").Randomization()
+	assert.Equal(t, []string{
+		"locally administered",
+		"known randomization pattern: Android scan randomization (Google CID)",
+	}, r.Reasons)
+	assert.InDelta(t, 0.9, r.Score, 1e-9)
+}
+
+func Test_AnalyzeSightings(t *testing.T) {
+	t.Run("rotating", func(t *testing.T) {
+		t.Parallel()
+		r := macaddr.AnalyzeSightings(
+			macaddr.MustParseMACAddress("12:a1:19:12:34:56"),
+			macaddr.MustParseMACAddress("3e:9b:40:01:02:03"),
+			macaddr.MustParseMACAddress("76:0f:8a:aa:bb:cc"),
+		)
+		assert.Equal(t, macaddr.RandomizationLikely, r.Verdict, r.String())
+	})
+	t.Run("stable", func(t *testing.T) {
+		t.Parallel()
+		m := macaddr.MustParseMACAddress("00:00:0c:12:34:56")
+		r := macaddr.AnalyzeSightings(m, m, m)
+		assert.Equal(t, macaddr.RandomizationUnlikely, r.Verdict, r.String())
+		assert.Zero(t, r.Score)
+	})
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+		r := macaddr.AnalyzeSightings()
+		assert.Equal(t, macaddr.RandomizationUnlikely, r.Verdict)
+	})
+}
+
+func Test_Randomization_String(t *testing.T) {
+	assert.Equal(t, "likely", macaddr.RandomizationLikely.String())
+	assert.Equal(t, "possible", macaddr.RandomizationPossible.String())
+	assert.Equal(t, "unlikely", macaddr.RandomizationUnlikely.String())
+}
+
+func ExampleMACAddress_Randomization() {
+	r := macaddr.MustParseMACAddress("f2:12:34:56:78:9a").Randomization()
+	fmt.Println(r.Verdict)
+	for _, reason := range r.Reasons {
+		fmt.Println(reason)
+	}
+	// Output:
+	// likely
+	// locally administered
+	// SLAP AAI quadrant, used for randomized addresses
+}
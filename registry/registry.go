@@ -0,0 +1,297 @@
+// Package registry parses the IEEE Registration Authority's public registry exports (MA-L, MA-M,
+// MA-S, CID and IAB) and provides offline vendor lookup for MAC addresses.
+//
+// The CSV exports are published at https://standards-oui.ieee.org, e.g. oui.csv, mam.csv,
+// oui36.csv, cid.csv and iab.csv. Each has the header:
+//
+//	Registry,Assignment,Organization Name,Organization Address
+package registry
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"go.mdl.wtf/go-macaddr"
+	"go.mdl.wtf/go-macaddr/internal/validate"
+)
+
+// Kind identifies which IEEE registry an assignment was made from.
+type Kind int
+
+const (
+	// KindUnknown is an unrecognized registry.
+	KindUnknown Kind = iota
+	// MAL is the MAC Address Block Large registry (/24, formerly OUI).
+	MAL
+	// MAM is the MAC Address Block Medium registry (/28).
+	MAM
+	// MAS is the MAC Address Block Small registry (/36, formerly OUI-36).
+	MAS
+	// CID is the Company ID registry (/24). CIDs are only used in locally administered space.
+	CID
+	// IAB is the retired Individual Address Block registry (/36).
+	IAB
+)
+
+var kindNames = map[Kind]string{
+	MAL: "MA-L",
+	MAM: "MA-M",
+	MAS: "MA-S",
+	CID: "CID",
+	IAB: "IAB",
+}
+
+// ParseKind parses a registry name as it appears in the IEEE exports, e.g. 'MA-L'.
+func ParseKind(s string) (Kind, error) {
+	for k, n := range kindNames {
+		if strings.EqualFold(n, strings.TrimSpace(s)) {
+			return k, nil
+		}
+	}
+	return KindUnknown, fmt.Errorf("'%v' is an unknown registry", s)
+}
+
+// String returns the registry name as it appears in the IEEE exports, e.g. 'MA-L'.
+func (k Kind) String() string {
+	if n, ok := kindNames[k]; ok {
+		return n
+	}
+	return "unknown"
+}
+
+// PrefixLen returns the prefix length of assignments made from the registry.
+func (k Kind) PrefixLen() int {
+	switch k {
+	case MAL, CID:
+		return 24
+	case MAM:
+		return 28
+	case MAS, IAB:
+		return 36
+	}
+	return 0
+}
+
+// Entry is a single assignment from an IEEE registry.
+type Entry struct {
+	// Kind is the registry the assignment was made from.
+	Kind Kind
+	// Prefix is the assigned block of addresses, e.g. 00:00:5e:00:00:00/24.
+	Prefix *macaddr.MACPrefix
+	// Organization is the name of the assignee.
+	Organization string
+	// Address is the postal address of the assignee, which may be empty or private.
+	Address string
+}
+
+// String returns a human-readable representation of the Entry.
+func (e *Entry) String() string {
+	if e == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("%s %s %s", e.Kind, e.Prefix, e.Organization)
+}
+
+// ParseEntry parses a single record of an IEEE registry export, in the column order Registry,
+// Assignment, Organization Name, Organization Address.
+func ParseEntry(record []string) (*Entry, error) {
+	if len(record) < 3 {
+		return nil, fmt.Errorf("expected at least 3 fields, got %d", len(record))
+	}
+	kind, err := ParseKind(record[0])
+	if err != nil {
+		return nil, err
+	}
+	a := strings.TrimSpace(record[1])
+	if !validate.BareHex(a) || len(a)*4 != kind.PrefixLen() {
+		return nil, fmt.Errorf("'%v' is an invalid %s assignment", record[1], kind)
+	}
+	mac, err := macaddr.ParseMACAddress(a)
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := mac.Prefix(kind.PrefixLen())
+	if err != nil {
+		return nil, err
+	}
+	e := &Entry{
+		Kind:         kind,
+		Prefix:       prefix,
+		Organization: strings.TrimSpace(record[2]),
+	}
+	if len(record) > 3 {
+		e.Address = strings.TrimSpace(record[3])
+	}
+	return e, nil
+}
+
+// Parse parses an IEEE registry CSV export. The header row is optional.
+func Parse(r io.Reader) ([]*Entry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	cr.TrimLeadingSpace = true
+
+	var entries []*Entry
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) == 0 || (len(record) == 1 && strings.TrimSpace(record[0]) == "") {
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(record[0]), "Registry") {
+			continue
+		}
+		e, err := ParseEntry(record)
+		if err != nil {
+			line, _ := cr.FieldPos(0)
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// ParseFile parses an IEEE registry CSV export from a file.
+func ParseFile(path string) ([]*Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Parse(f)
+}
+
+// Registry is a set of IEEE registry entries indexed for longest-prefix lookup. The zero value is
+// an empty Registry ready to use.
+type Registry struct {
+	// entries maps prefix lengths to the integer value of each assigned prefix.
+	entries map[int]map[int64]*Entry
+	// lens contains each prefix length present in entries, longest first.
+	lens []int
+}
+
+// New creates a Registry from zero or more entries.
+func New(entries ...*Entry) *Registry {
+	r := &Registry{}
+	r.Add(entries...)
+	return r
+}
+
+// Load parses an IEEE registry CSV export and adds all of its entries to the Registry.
+func (r *Registry) Load(rd io.Reader) error {
+	entries, err := Parse(rd)
+	if err != nil {
+		return err
+	}
+	r.Add(entries...)
+	return nil
+}
+
+// LoadFile parses an IEEE registry CSV export from a file and adds all of its entries to the
+// Registry.
+func (r *Registry) LoadFile(path string) error {
+	entries, err := ParseFile(path)
+	if err != nil {
+		return err
+	}
+	r.Add(entries...)
+	return nil
+}
+
+// Add adds entries to the Registry. An entry with the same prefix as an existing entry replaces
+// it.
+func (r *Registry) Add(entries ...*Entry) {
+	if r.entries == nil {
+		r.entries = make(map[int]map[int64]*Entry)
+	}
+	for _, e := range entries {
+		if e == nil || e.Prefix == nil {
+			continue
+		}
+		l := e.Prefix.PrefixLen()
+		if _, ok := r.entries[l]; !ok {
+			r.entries[l] = make(map[int64]*Entry)
+			r.lens = append(r.lens, l)
+			sort.Sort(sort.Reverse(sort.IntSlice(r.lens)))
+		}
+		r.entries[l][e.Prefix.MAC.Int()] = e
+	}
+}
+
+// Len returns the number of entries in the Registry.
+func (r *Registry) Len() int {
+	n := 0
+	for _, m := range r.entries {
+		n += len(m)
+	}
+	return n
+}
+
+// Entries returns all entries in the Registry, sorted by prefix and then prefix length.
+func (r *Registry) Entries() []*Entry {
+	entries := make([]*Entry, 0, r.Len())
+	for _, m := range r.entries {
+		for _, e := range m {
+			entries = append(entries, e)
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		a, b := entries[i].Prefix, entries[j].Prefix
+		if a.MAC.Equal(b.MAC) {
+			return a.PrefixLen() < b.PrefixLen()
+		}
+		return a.MAC.LessThan(b.MAC)
+	})
+	return entries
+}
+
+// Lookup returns the most specific entry containing a MACAddress, e.g. an MA-S assignment is
+// preferred over the MA-L assignment covering it. Lookup returns nil if no entry matches.
+func (r *Registry) Lookup(mac *macaddr.MACAddress) *Entry {
+	if mac == nil {
+		return nil
+	}
+	for _, l := range r.lens {
+		p, err := mac.Prefix(l)
+		if err != nil {
+			return nil
+		}
+		if e, ok := r.entries[l][p.MAC.Int()]; ok {
+			return e
+		}
+	}
+	return nil
+}
+
+// LookupPrefix returns the most specific entry containing an entire MACPrefix. LookupPrefix
+// returns nil if no entry matches.
+func (r *Registry) LookupPrefix(p *macaddr.MACPrefix) *Entry {
+	if p == nil || p.MAC == nil {
+		return nil
+	}
+	pl := p.PrefixLen()
+	for _, l := range r.lens {
+		if l > pl {
+			continue
+		}
+		bp, err := p.MAC.Prefix(l)
+		if err != nil {
+			return nil
+		}
+		if e, ok := r.entries[l][bp.MAC.Int()]; ok {
+			return e
+		}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package registry_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+	"go.mdl.wtf/go-macaddr/registry"
+)
+
+var files = []string{"oui.csv", "mam.csv", "oui36.csv", "cid.csv", "iab.csv"}
+
+func loadAll(t *testing.T) *registry.Registry {
+	t.Helper()
+	r := registry.New()
+	for _, f := range files {
+		require.NoError(t, r.LoadFile(filepath.Join("testdata", f)))
+	}
+	return r
+}
+
+func Test_Kind(t *testing.T) {
+	type pair struct {
+		s string
+		k registry.Kind
+		l int
+	}
+	tests := []pair{
+		{"MA-L", registry.MAL, 24},
+		{"ma-m", registry.MAM, 28},
+		{"MA-S", registry.MAS, 36},
+		{"CID", registry.CID, 24},
+		{" IAB ", registry.IAB, 36},
+	}
+	for i, p := range tests {
+		p := p
+		t.Run(fmt.Sprint(i+1), func(t *testing.T) {
+			t.Parallel()
+			k, err := registry.ParseKind(p.s)
+			require.NoError(t, err)
+			assert.Equal(t, p.k, k)
+			assert.Equal(t, p.l, k.PrefixLen())
+			assert.Equal(t, strings.ToUpper(strings.TrimSpace(p.s)), k.String())
+		})
+	}
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+		k, err := registry.ParseKind("OUI")
+		require.Error(t, err)
+		assert.Equal(t, registry.KindUnknown, k)
+		assert.Equal(t, "unknown", k.String())
+		assert.Equal(t, 0, k.PrefixLen())
+	})
+}
+
+func Test_ParseEntry(t *testing.T) {
+	t.Run("MA-M", func(t *testing.T) {
+		t.Parallel()
+		e, err := registry.ParseEntry([]string{"MA-M", "00005E1", " Example Co ", "Somewhere"})
+		require.NoError(t, err)
+		assert.Equal(t, registry.MAM, e.Kind)
+		assert.Equal(t, "00:00:5e:10:00:00/28", e.Prefix.String())
+		assert.Equal(t, "Example Co", e.Organization)
+		assert.Equal(t, "Somewhere", e.Address)
+		assert.Equal(t, "MA-M 00:00:5e:10:00:00/28 Example Co", e.String())
+	})
+	t.Run("no address", func(t *testing.T) {
+		t.Parallel()
+		e, err := registry.ParseEntry([]string{"MA-L", "00005E", "Example Co"})
+		require.NoError(t, err)
+		assert.Empty(t, e.Address)
+	})
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		records := [][]string{
+			{"MA-L", "00005E"},
+			{"OUI", "00005E", "Example Co"},
+			{"MA-L", "00005E1", "Example Co"},
+			{"MA-S", "00005Z100", "Example Co"},
+		}
+		for _, r := range records {
+			_, err := registry.ParseEntry(r)
+			assert.Error(t, err, r)
+		}
+	})
+	t.Run("nil String()", func(t *testing.T) {
+		t.Parallel()
+		var e *registry.Entry
+		assert.Equal(t, "<nil>", e.String())
+	})
+}
+
+func Test_Parse(t *testing.T) {
+	t.Run("files", func(t *testing.T) {
+		t.Parallel()
+		entries, err := registry.ParseFile(filepath.Join("testdata", "oui.csv"))
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+		assert.Equal(t, "Example Networks, Inc.", entries[0].Organization)
+		assert.Equal(t, "1 Example Way Springfield US 00000", entries[0].Address)
+	})
+	t.Run("no header", func(t *testing.T) {
+		t.Parallel()
+		entries, err := registry.Parse(strings.NewReader("MA-L,00005E,Example\n\nCID,0A1234,Example CID\n"))
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+	})
+	t.Run("line error", func(t *testing.T) {
+		t.Parallel()
+		_, err := registry.ParseFile(filepath.Join("testdata", "invalid.csv"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "line 3")
+	})
+	t.Run("missing file", func(t *testing.T) {
+		t.Parallel()
+		_, err := registry.ParseFile(filepath.Join("testdata", "missing.csv"))
+		require.Error(t, err)
+		r := registry.New()
+		require.Error(t, r.LoadFile(filepath.Join("testdata", "missing.csv")))
+		require.Error(t, r.Load(strings.NewReader("MA-L,0\n")))
+	})
+}
+
+func Test_Registry(t *testing.T) {
+	r := loadAll(t)
+	t.Run("Len()", func(t *testing.T) {
+		t.Parallel()
+		assert.Equal(t, 9, r.Len())
+		assert.Len(t, r.Entries(), 9)
+	})
+	t.Run("Entries() sorted", func(t *testing.T) {
+		t.Parallel()
+		entries := r.Entries()
+		assert.Equal(t, "00:00:5e:00:00:00/24", entries[0].Prefix.String())
+		assert.Equal(t, "00:00:5e:10:00:00/28", entries[1].Prefix.String())
+		assert.Equal(t, "00:00:5e:10:00:00/36", entries[2].Prefix.String())
+	})
+	t.Run("Lookup() longest prefix", func(t *testing.T) {
+		t.Parallel()
+		type pair struct {
+			mac string
+			org string
+		}
+		tests := []pair{
+			{"00:00:5e:00:53:ab", "Example Networks, Inc."},
+			{"00:00:5e:1f:ff:ff", "Example Medium Block Co"},
+			{"00:00:5e:10:00:ff", "Example Smallest Co"},
+			{"70:b3:d5:00:10:01", "Example Small Block Co"},
+			{"70:b3:d5:00:20:01", "IEEE Registration Authority"},
+			{"00:50:c2:00:1a:bc", "Example Individual Block"},
+			{"0a:12:34:56:78:9a", "Example CID Holder"},
+		}
+		for _, p := range tests {
+			e := r.Lookup(macaddr.MustParseMACAddress(p.mac))
+			require.NotNil(t, e, p.mac)
+			assert.Equal(t, p.org, e.Organization, p.mac)
+		}
+	})
+	t.Run("Lookup() no match", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, r.Lookup(macaddr.MustParseMACAddress("ff:ff:ff:ff:ff:ff")))
+		assert.Nil(t, r.Lookup(nil))
+		assert.Nil(t, r.Lookup(&macaddr.MACAddress{0x00}))
+	})
+	t.Run("LookupPrefix()", func(t *testing.T) {
+		t.Parallel()
+		_, p := macaddr.MustParseMACPrefix("00:00:5e:10:00:00/32")
+		assert.Equal(t, "Example Medium Block Co", r.LookupPrefix(p).Organization)
+		_, p = macaddr.MustParseMACPrefix("00:00:5e:00:00:00/16")
+		assert.Nil(t, r.LookupPrefix(p))
+		assert.Nil(t, r.LookupPrefix(nil))
+	})
+	t.Run("zero value", func(t *testing.T) {
+		t.Parallel()
+		var r registry.Registry
+		assert.Equal(t, 0, r.Len())
+		assert.Nil(t, r.Lookup(macaddr.MustParseMACAddress("00:00:5e:00:53:ab")))
+		r.Add(nil)
+		e, err := registry.ParseEntry([]string{"MA-L", "00005E", "Example"})
+		require.NoError(t, err)
+		r.Add(e)
+		assert.Equal(t, e, r.Lookup(macaddr.MustParseMACAddress("00:00:5e:00:53:ab")))
+	})
+}
+
+func ExampleRegistry_Lookup() {
+	r := registry.New()
+	err := r.Load(strings.NewReader(`Registry,Assignment,Organization Name,Organization Address
+MA-L,00005E,Example Networks,
+MA-S,00005E005,Example Labs,
+`))
+	if err != nil {
+		panic(err)
+	}
+	e := r.Lookup(macaddr.MustParseMACAddress("00:00:5e:00:53:ab"))
+	fmt.Println(e.Kind, e.Prefix, e.Organization)
+	// Output:
+	// MA-S 00:00:5e:00:50:00/36 Example Labs
+}
@@ -0,0 +1,112 @@
+package macaddr
+
+import "go.mdl.wtf/go-macaddr/internal/constant"
+
+// SLAPQuadrant is an IEEE 802c Structured Local Address Plan (SLAP) quadrant, identified by the
+// Y and Z bits (0x04 and 0x08) of the first octet of a locally administered address.
+type SLAPQuadrant int
+
+const (
+	// SLAPNone is the quadrant of universally administered (or invalid) addresses, which are not
+	// part of the SLAP.
+	SLAPNone SLAPQuadrant = iota
+	// SLAPELI is the Extended Local Identifier quadrant (Y=0, Z=1), e.g. 'xA:xx:xx:xx:xx:xx'.
+	// ELI addresses begin with a Company ID (CID) assigned by the IEEE RA.
+	SLAPELI
+	// SLAPSAI is the Standard Assigned Identifier quadrant (Y=1, Z=1), e.g. 'xE:xx:xx:xx:xx:xx'.
+	// SAI addresses are assigned by a protocol specified in an IEEE 802 standard.
+	SLAPSAI
+	// SLAPAAI is the Administratively Assigned Identifier quadrant (Y=0, Z=0), e.g.
+	// 'x2:xx:xx:xx:xx:xx'. AAI addresses are assigned locally, and include randomized addresses.
+	SLAPAAI
+	// SLAPReserved is the quadrant reserved for future use (Y=1, Z=0), e.g. 'x6:xx:xx:xx:xx:xx'.
+	SLAPReserved
+)
+
+const (
+	// slapMask masks the Y and Z bits of the first octet.
+	slapMask byte = 0x0c
+	// slapPrefixLen is the minimum prefix length covering the U/L, Y and Z bits.
+	slapPrefixLen = 7
+	// cidPrefixLen is the prefix length of an IEEE Company ID.
+	cidPrefixLen = 24
+)
+
+// slapBits maps each SLAPQuadrant to its Y and Z bits.
+var slapBits = map[SLAPQuadrant]byte{
+	SLAPELI:      0x08,
+	SLAPSAI:      0x0c,
+	SLAPAAI:      0x00,
+	SLAPReserved: 0x04,
+}
+
+// String returns the name of the SLAPQuadrant, e.g. 'ELI'.
+func (q SLAPQuadrant) String() string {
+	switch q {
+	case SLAPELI:
+		return "ELI"
+	case SLAPSAI:
+		return "SAI"
+	case SLAPAAI:
+		return "AAI"
+	case SLAPReserved:
+		return "Reserved"
+	}
+	return "None"
+}
+
+// slapQuadrant returns the SLAPQuadrant of a first octet.
+func slapQuadrant(b byte) SLAPQuadrant {
+	if b&constant.LocalBit == 0 {
+		return SLAPNone
+	}
+	for q, bits := range slapBits {
+		if b&slapMask == bits {
+			return q
+		}
+	}
+	return SLAPNone
+}
+
+// SLAPQuadrant returns the IEEE 802c SLAP quadrant of the MACAddress, or SLAPNone if the
+// MACAddress is universally administered.
+func (m *MACAddress) SLAPQuadrant() SLAPQuadrant {
+	if m == nil || len(*m) != constant.MacByteLen {
+		return SLAPNone
+	}
+	return slapQuadrant((*m)[0])
+}
+
+// SLAPQuadrant returns the IEEE 802c SLAP quadrant of the Addr, or SLAPNone if the Addr is
+// universally administered or invalid.
+func (a Addr) SLAPQuadrant() SLAPQuadrant {
+	if !a.IsValid() {
+		return SLAPNone
+	}
+	return slapQuadrant(a.firstOctet())
+}
+
+// SLAPQuadrant returns the IEEE 802c SLAP quadrant every address in the MACPrefix lies in, or
+// SLAPNone if the prefix length does not cover the U/L, Y and Z bits or the MACPrefix is
+// universally administered.
+func (p *MACPrefix) SLAPQuadrant() SLAPQuadrant {
+	if !p.covers(slapPrefixLen) {
+		return SLAPNone
+	}
+	return p.MAC.SLAPQuadrant()
+}
+
+// CID returns the IEEE Company ID (CID) of an Extended Local Identifier (ELI) address, as a /24
+// MACPrefix. The individual/group (I/G) bit of the CID is cleared, so group ELI addresses return
+// the same CID as individual addresses. If the MACAddress is not in the ELI quadrant, CID returns
+// false.
+func (m *MACAddress) CID() (*MACPrefix, bool) {
+	if m.SLAPQuadrant() != SLAPELI {
+		return nil, false
+	}
+	p, err := m.SetMulticast(false).Prefix(cidPrefixLen)
+	if err != nil {
+		return nil, false
+	}
+	return p, true
+}
@@ -0,0 +1,92 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_MACAddress_SLAPQuadrant(t *testing.T) {
+	type pair struct {
+		mac string
+		q   macaddr.SLAPQuadrant
+	}
+	tests := []pair{
+		{"00:00:5e:00:53:ab", macaddr.SLAPNone},
+		{"0c:00:5e:00:53:ab", macaddr.SLAPNone},
+		{"02:00:5e:00:53:ab", macaddr.SLAPAAI},
+		{"f3:00:5e:00:53:ab", macaddr.SLAPAAI},
+		{"06:00:5e:00:53:ab", macaddr.SLAPReserved},
+		{"0a:00:5e:00:53:ab", macaddr.SLAPELI},
+		{"7b:00:5e:00:53:ab", macaddr.SLAPELI},
+		{"0e:00:5e:00:53:ab", macaddr.SLAPSAI},
+		{"ff:ff:ff:ff:ff:ff", macaddr.SLAPSAI},
+	}
+	for _, p := range tests {
+		p := p
+		t.Run(p.mac, func(t *testing.T) {
+			t.Parallel()
+			m := macaddr.MustParseMACAddress(p.mac)
+			assert.Equal(t, p.q, m.SLAPQuadrant())
+			assert.Equal(t, p.q, m.Addr().SLAPQuadrant())
+		})
+	}
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		var m *macaddr.MACAddress
+		assert.Equal(t, macaddr.SLAPNone, m.SLAPQuadrant())
+		assert.Equal(t, macaddr.SLAPNone, macaddr.Addr{}.SLAPQuadrant())
+	})
+}
+
+func Test_SLAPQuadrant_String(t *testing.T) {
+	assert.Equal(t, "ELI", macaddr.SLAPELI.String())
+	assert.Equal(t, "SAI", macaddr.SLAPSAI.String())
+	assert.Equal(t, "AAI", macaddr.SLAPAAI.String())
+	assert.Equal(t, "Reserved", macaddr.SLAPReserved.String())
+	assert.Equal(t, "None", macaddr.SLAPNone.String())
+}
+
+func Test_MACPrefix_SLAPQuadrant(t *testing.T) {
+	_, p := macaddr.MustParseMACPrefix("0a:00:5e:00:00:00/24")
+	assert.Equal(t, macaddr.SLAPELI, p.SLAPQuadrant())
+	_, p = macaddr.MustParseMACPrefix("0a:00:00:00:00:00/7")
+	assert.Equal(t, macaddr.SLAPELI, p.SLAPQuadrant())
+	_, p = macaddr.MustParseMACPrefix("0a:00:00:00:00:00/6")
+	assert.Equal(t, macaddr.SLAPNone, p.SLAPQuadrant())
+	assert.Equal(t, macaddr.SLAPNone, (&macaddr.MACPrefix{}).SLAPQuadrant())
+	var n *macaddr.MACPrefix
+	assert.Equal(t, macaddr.SLAPNone, n.SLAPQuadrant())
+}
+
+func Test_MACAddress_CID(t *testing.T) {
+	t.Run("ELI", func(t *testing.T) {
+		t.Parallel()
+		cid, ok := macaddr.MustParseMACAddress("0a:00:5e:00:53:ab").CID()
+		assert.True(t, ok)
+		assert.Equal(t, "0a:00:5e:00:00:00/24", cid.String())
+		cid, ok = macaddr.MustParseMACAddress("0b:00:5e:00:53:ab").CID()
+		assert.True(t, ok)
+		assert.Equal(t, "0a:00:5e:00:00:00/24", cid.String())
+	})
+	t.Run("not ELI", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range []string{"00:00:5e:00:53:ab", "02:00:5e:00:53:ab", "0e:00:5e:00:53:ab"} {
+			cid, ok := macaddr.MustParseMACAddress(s).CID()
+			assert.False(t, ok, s)
+			assert.Nil(t, cid, s)
+		}
+	})
+}
+
+func ExampleMACAddress_SLAPQuadrant() {
+	mac := macaddr.MustParseMACAddress("0a:00:5e:00:53:ab")
+	fmt.Println(mac.SLAPQuadrant())
+	cid, _ := mac.CID()
+	fmt.Println(cid.String())
+	// Output:
+	// ELI
+	// 0a:00:5e:00:00:00/24
+}
@@ -0,0 +1,157 @@
+package macaddr
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// SQLFormat is the representation a MACAddress is written to a database with.
+type SQLFormat int
+
+const (
+	// SQLText writes a MACAddress as a colon-separated string, e.g. 'xx:xx:xx:xx:xx:xx'. This is
+	// suitable for PostgreSQL macaddr columns and text columns.
+	SQLText SQLFormat = iota
+	// SQLBytes writes a MACAddress as 6 raw bytes, e.g. for MySQL BINARY(6) columns.
+	SQLBytes
+	// SQLInt writes a MACAddress as an int64, as returned by MACAddress.Int.
+	SQLInt
+)
+
+// Value implements driver.Valuer, writing the MACAddress as SQLText. Use MACAddress.SQLValue to
+// write a value in another representation. An empty MACAddress is written as NULL.
+func (m MACAddress) Value() (driver.Value, error) {
+	return m.ValueAs(SQLText)
+}
+
+// sqlValue is a MACAddress written to a database in a specific representation.
+type sqlValue struct {
+	mac    MACAddress
+	format SQLFormat
+}
+
+// Value implements driver.Valuer.
+func (v sqlValue) Value() (driver.Value, error) {
+	return v.mac.ValueAs(v.format)
+}
+
+// SQLValue returns a driver.Valuer that writes the MACAddress in the representation f, e.g. to
+// insert into a BINARY(6) column:
+//
+//	db.Exec("INSERT INTO hosts (mac) VALUES (?)", mac.SQLValue(macaddr.SQLBytes))
+func (m MACAddress) SQLValue(f SQLFormat) driver.Valuer {
+	return sqlValue{mac: m, format: f}
+}
+
+// ValueAs returns the MACAddress as a driver.Value in the representation f. An empty MACAddress
+// returns nil.
+func (m MACAddress) ValueAs(f SQLFormat) (driver.Value, error) {
+	if len(m) == 0 {
+		return nil, nil
+	}
+	if len(m) != constant.MacByteLen {
+		return nil, fmt.Errorf("'%v' is an invalid MAC address", []byte(m))
+	}
+	switch f {
+	case SQLText:
+		return m.String(), nil
+	case SQLBytes:
+		b := make([]byte, constant.MacByteLen)
+		copy(b, m)
+		return b, nil
+	case SQLInt:
+		return m.Int(), nil
+	}
+	return nil, fmt.Errorf("'%d' is an invalid SQL format", f)
+}
+
+// Scan implements sql.Scanner. Text in any format accepted by ParseMACAddress, raw 6 byte values,
+// and integers as returned by MACAddress.Int are accepted. 8 byte values, such as those from a
+// PostgreSQL macaddr8 column, are accepted only if they are EUI-48 addresses encapsulated in
+// EUI-64 (i.e. contain ff:fe in the fourth and fifth bytes). NULL scans to an empty MACAddress.
+func (m *MACAddress) Scan(src any) error {
+	var mac *MACAddress
+	var err error
+	switch v := src.(type) {
+	case nil:
+		*m = nil
+		return nil
+	case string:
+		mac, err = parseSQLText(v)
+	case []byte:
+		switch len(v) {
+		case constant.MacByteLen:
+			mac = FromByteArray(v)
+		case constant.EUI64ByteLen:
+			mac, err = macFromEncapsulatedEUI64(EUI64FromByteArray(v))
+		default:
+			mac, err = parseSQLText(string(v))
+		}
+	case int64:
+		if v < 0 || v > int64(addrBits) {
+			return fmt.Errorf("'%d' is out of range for a MAC address", v)
+		}
+		mac = AddrFromUint64(uint64(v)).MACAddress()
+	default:
+		return fmt.Errorf("cannot scan %T into MACAddress", src)
+	}
+	if err != nil {
+		return err
+	}
+	*m = *mac
+	return nil
+}
+
+// parseSQLText parses a MACAddress from a text column, additionally accepting EUI-48 addresses
+// encapsulated in EUI-64.
+func parseSQLText(s string) (*MACAddress, error) {
+	mac, err := ParseMACAddress(s)
+	var le *LengthError
+	if errors.As(err, &le) && le.Kind == KindEUI64 {
+		eui, err := ParseEUI64(s)
+		if err != nil {
+			return nil, err
+		}
+		return macFromEncapsulatedEUI64(eui)
+	}
+	return mac, err
+}
+
+// macFromEncapsulatedEUI64 recovers an EUI-48 address encapsulated in an EUI-64 address, e.g.
+// 00:00:5e:ff:fe:00:53:ab becomes 00:00:5e:00:53:ab. Unlike a modified EUI-64 identifier, the
+// universal/local bit is not inverted.
+func macFromEncapsulatedEUI64(e *EUI64) (*MACAddress, error) {
+	b := *e
+	if b[3] != 0xff || b[4] != 0xfe {
+		return nil, &LengthError{Input: e.String(), Kind: KindEUI64, Len: constant.EUI64ByteLen, Want: constant.MacByteLen}
+	}
+	return FromBytes(b[0], b[1], b[2], b[5], b[6], b[7]), nil
+}
+
+// Value implements driver.Valuer, writing the MACPrefix in the same format as String. An empty
+// MACPrefix is written as NULL.
+func (p MACPrefix) Value() (driver.Value, error) {
+	b, err := p.MarshalText()
+	if err != nil || len(b) == 0 {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan implements sql.Scanner, accepting text in any format accepted by ParseMACPrefix. NULL
+// scans to an empty MACPrefix.
+func (p *MACPrefix) Scan(src any) error {
+	switch v := src.(type) {
+	case nil:
+		*p = MACPrefix{}
+		return nil
+	case string:
+		return p.UnmarshalText([]byte(v))
+	case []byte:
+		return p.UnmarshalText(v)
+	}
+	return fmt.Errorf("cannot scan %T into MACPrefix", src)
+}
@@ -0,0 +1,141 @@
+package macaddr_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+var (
+	_ sql.Scanner   = (*macaddr.MACAddress)(nil)
+	_ driver.Valuer = macaddr.MACAddress{}
+	_ sql.Scanner   = (*macaddr.MACPrefix)(nil)
+	_ driver.Valuer = macaddr.MACPrefix{}
+)
+
+func Test_MACAddress_Value(t *testing.T) {
+	m := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+	t.Run("default", func(t *testing.T) {
+		v, err := m.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:53:ab", v)
+	})
+	t.Run("SQLValue()", func(t *testing.T) {
+		t.Parallel()
+		v, err := m.SQLValue(macaddr.SQLBytes).Value()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{0, 0, 0x5e, 0, 0x53, 0xab}, v)
+		v, err = m.SQLValue(macaddr.SQLInt).Value()
+		require.NoError(t, err)
+		assert.Equal(t, int64(1577079723), v)
+		v, err = m.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:53:ab", v)
+		v, err = macaddr.MACAddress{}.SQLValue(macaddr.SQLBytes).Value()
+		require.NoError(t, err)
+		assert.Nil(t, v)
+		_, err = m.SQLValue(macaddr.SQLFormat(99)).Value()
+		require.Error(t, err)
+	})
+	t.Run("ValueAs()", func(t *testing.T) {
+		v, err := m.ValueAs(macaddr.SQLInt)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1577079723), v)
+		_, err = m.ValueAs(macaddr.SQLFormat(99))
+		require.Error(t, err)
+	})
+	t.Run("empty", func(t *testing.T) {
+		v, err := macaddr.MACAddress{}.Value()
+		require.NoError(t, err)
+		assert.Nil(t, v)
+		_, err = macaddr.MACAddress{0x01}.Value()
+		require.Error(t, err)
+	})
+}
+
+func Test_MACAddress_Scan(t *testing.T) {
+	type pair struct {
+		src any
+		out string
+	}
+	tests := []pair{
+		{"00:00:5e:00:53:ab", "00:00:5e:00:53:ab"},
+		{"0000.5e00.53ab", "00:00:5e:00:53:ab"},
+		{[]byte("00-00-5e-00-53-ab"), "00:00:5e:00:53:ab"},
+		{[]byte{0, 0, 0x5e, 0, 0x53, 0xab}, "00:00:5e:00:53:ab"},
+		{[]byte{0, 0, 0x5e, 0xff, 0xfe, 0, 0x53, 0xab}, "00:00:5e:00:53:ab"},
+		{"00:00:5e:ff:fe:00:53:ab", "00:00:5e:00:53:ab"},
+		{int64(1577079723), "00:00:5e:00:53:ab"},
+	}
+	for i, p := range tests {
+		p := p
+		t.Run(fmt.Sprintf("scan %d", i+1), func(t *testing.T) {
+			t.Parallel()
+			var m macaddr.MACAddress
+			require.NoError(t, m.Scan(p.src))
+			assert.Equal(t, p.out, m.String())
+		})
+	}
+	t.Run("NULL", func(t *testing.T) {
+		t.Parallel()
+		m := *macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+		require.NoError(t, m.Scan(nil))
+		assert.Nil(t, m)
+	})
+	t.Run("EUI-64 is not truncated", func(t *testing.T) {
+		t.Parallel()
+		var m macaddr.MACAddress
+		var le *macaddr.LengthError
+		err := m.Scan("02:00:5e:10:00:00:00:01")
+		assert.True(t, errors.As(err, &le))
+		err = m.Scan([]byte{0x02, 0, 0x5e, 0x10, 0, 0, 0, 0x01})
+		assert.True(t, errors.As(err, &le))
+	})
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		var m macaddr.MACAddress
+		for _, src := range []any{"this should error", int64(-1), int64(1 << 48), 1.5} {
+			assert.Error(t, m.Scan(src), src)
+		}
+	})
+}
+
+func Test_MACPrefix_SQL(t *testing.T) {
+	t.Run("Value()", func(t *testing.T) {
+		t.Parallel()
+		_, p := macaddr.MustParseMACPrefix("00:00:5e:00:53:00/24")
+		v, err := p.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "00:00:5e:00:00:00/24", v)
+		v, err = macaddr.MACPrefix{}.Value()
+		require.NoError(t, err)
+		assert.Nil(t, v)
+	})
+	t.Run("Scan()", func(t *testing.T) {
+		t.Parallel()
+		var p macaddr.MACPrefix
+		require.NoError(t, p.Scan("00:00:5e:00:53:00/28"))
+		assert.Equal(t, "00:00:5e:00:00:00/28", p.String())
+		require.NoError(t, p.Scan([]byte("00:00:5e:00:53:00/24")))
+		assert.Equal(t, "00:00:5e:00:00:00/24", p.String())
+		require.NoError(t, p.Scan(nil))
+		assert.Nil(t, p.MAC)
+		require.Error(t, p.Scan(int64(1)))
+	})
+}
+
+func ExampleMACAddress_Scan() {
+	var mac macaddr.MACAddress
+	if err := mac.Scan([]byte{0x00, 0x00, 0x5e, 0x00, 0x53, 0xab}); err != nil {
+		panic(err)
+	}
+	fmt.Println(mac.String())
+	// Output:
+	// 00:00:5e:00:53:ab
+}
@@ -0,0 +1,83 @@
+package macaddr
+
+import (
+	"fmt"
+	"math/bits"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// MACSubnetIterator tracks iteration state while iterating through the child prefixes of a
+// MACPrefix. Child prefixes are created as they are iterated, so splitting a large MACPrefix into
+// many small ones does not allocate them all at once.
+type MACSubnetIterator struct {
+	base    uint64
+	step    uint64
+	count   uint64
+	runs    uint64
+	len     int
+	current *MACPrefix
+}
+
+// Next iterates through the child prefixes.
+func (i *MACSubnetIterator) Next() bool {
+	if i == nil || i.runs >= i.count {
+		return false
+	}
+	i.current = nil
+	i.runs++
+	return true
+}
+
+// Value returns the current child prefix.
+func (i *MACSubnetIterator) Value() *MACPrefix {
+	if i == nil || i.runs == 0 || i.runs > i.count {
+		panic(fmt.Errorf("cannot call Value() before Next() or after iterator has finished"))
+	}
+	if i.current == nil {
+		mask := MaskFromPrefixLen(i.len)
+		i.current = &MACPrefix{MAC: AddrFromUint64(i.base + (i.runs-1)*i.step).MACAddress(), Mask: mask}
+	}
+	return i.current
+}
+
+// Len returns the total number of child prefixes.
+func (i *MACSubnetIterator) Len() int {
+	if i == nil {
+		return 0
+	}
+	return int(i.count)
+}
+
+// Subnets creates an iterator over every child prefix of length l within the MACPrefix, in
+// ascending order. For example, a prefix length of 26 and a MACPrefix of 00:00:5e:00:00:00/24
+// would iterate over 00:00:5e:00:00:00/26, 00:00:5e:40:00:00/26, 00:00:5e:80:00:00/26 and
+// 00:00:5e:c0:00:00/26. l must not be less than the MACPrefix's prefix length.
+func (p *MACPrefix) Subnets(l int) (*MACSubnetIterator, error) {
+	if !p.covers(0) {
+		return nil, fmt.Errorf("'%s' is an invalid MAC prefix", p.String())
+	}
+	pl := p.PrefixLen()
+	if l < pl || l > constant.MacBitLen {
+		return nil, fmt.Errorf("'%d' is an invalid subnet prefix length for %s", l, p.String())
+	}
+	base, _ := p.span()
+	return &MACSubnetIterator{
+		base:  base,
+		step:  1 << (constant.MacBitLen - l),
+		count: 1 << (l - pl),
+		len:   l,
+	}, nil
+}
+
+// Split creates an iterator over n equal child prefixes of the MACPrefix, in ascending order. n
+// must be a power of two, and no greater than the number of addresses in the MACPrefix.
+func (p *MACPrefix) Split(n int) (*MACSubnetIterator, error) {
+	if n <= 0 || n&(n-1) != 0 {
+		return nil, fmt.Errorf("'%d' is not a power of two", n)
+	}
+	if !p.covers(0) {
+		return nil, fmt.Errorf("'%s' is an invalid MAC prefix", p.String())
+	}
+	return p.Subnets(p.PrefixLen() + bits.TrailingZeros(uint(n)))
+}
@@ -0,0 +1,126 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_MACPrefix_Subnets(t *testing.T) {
+	_, p := macaddr.MustParseMACPrefix("00:00:5e:00:00:00/24")
+	t.Run("Subnets()", func(t *testing.T) {
+		t.Parallel()
+		iter, err := p.Subnets(26)
+		require.NoError(t, err)
+		assert.Equal(t, 4, iter.Len())
+		var out []string
+		for iter.Next() {
+			out = append(out, iter.Value().String())
+		}
+		assert.Equal(t, []string{
+			"00:00:5e:00:00:00/26",
+			"00:00:5e:40:00:00/26",
+			"00:00:5e:80:00:00/26",
+			"00:00:5e:c0:00:00/26",
+		}, out)
+		assert.False(t, iter.Next())
+	})
+	t.Run("same length", func(t *testing.T) {
+		t.Parallel()
+		iter, err := p.Subnets(24)
+		require.NoError(t, err)
+		require.True(t, iter.Next())
+		assert.Equal(t, p.String(), iter.Value().String())
+		assert.False(t, iter.Next())
+	})
+	t.Run("large", func(t *testing.T) {
+		t.Parallel()
+		iter, err := p.Subnets(44)
+		require.NoError(t, err)
+		assert.Equal(t, 1<<20, iter.Len())
+		n := 0
+		var last *macaddr.MACPrefix
+		for iter.Next() {
+			n++
+			if n == iter.Len() {
+				last = iter.Value()
+			}
+		}
+		assert.Equal(t, 1<<20, n)
+		assert.Equal(t, "00:00:5e:ff:ff:f0/44", last.String())
+	})
+	t.Run("host bits", func(t *testing.T) {
+		t.Parallel()
+		h := &macaddr.MACPrefix{MAC: macaddr.MustParseMACAddress("00:00:5e:12:34:56"), Mask: p.Mask}
+		iter, err := h.Subnets(25)
+		require.NoError(t, err)
+		var out []string
+		for iter.Next() {
+			out = append(out, iter.Value().String())
+		}
+		assert.Equal(t, []string{"00:00:5e:00:00:00/25", "00:00:5e:80:00:00/25"}, out)
+	})
+	t.Run("whole space", func(t *testing.T) {
+		t.Parallel()
+		_, all := macaddr.MustParseMACPrefix("00:00:00:00:00:00/0")
+		iter, err := all.Split(2)
+		require.NoError(t, err)
+		require.True(t, iter.Next())
+		require.True(t, iter.Next())
+		assert.Equal(t, "80:00:00:00:00:00/1", iter.Value().String())
+	})
+	t.Run("Split()", func(t *testing.T) {
+		t.Parallel()
+		iter, err := p.Split(8)
+		require.NoError(t, err)
+		assert.Equal(t, 8, iter.Len())
+		require.True(t, iter.Next())
+		require.True(t, iter.Next())
+		assert.Equal(t, "00:00:5e:20:00:00/27", iter.Value().String())
+		_, err = p.Split(3)
+		assert.Error(t, err)
+		_, err = p.Split(0)
+		assert.Error(t, err)
+		_, err = p.Split(1 << 25)
+		assert.Error(t, err)
+	})
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		_, err := p.Subnets(23)
+		assert.Error(t, err)
+		_, err = p.Subnets(49)
+		assert.Error(t, err)
+		var n *macaddr.MACPrefix
+		_, err = n.Subnets(48)
+		assert.Error(t, err)
+		_, err = (&macaddr.MACPrefix{}).Subnets(48)
+		assert.Error(t, err)
+		_, err = (&macaddr.MACPrefix{}).Split(2)
+		assert.Error(t, err)
+		iter, err := p.Subnets(25)
+		require.NoError(t, err)
+		assert.Panics(t, func() { iter.Value() })
+		var ni *macaddr.MACSubnetIterator
+		assert.False(t, ni.Next())
+		assert.Zero(t, ni.Len())
+	})
+}
+
+func ExampleMACPrefix_Split() {
+	_, p := macaddr.MustParseMACPrefix("02:00:5e:00:00:00/24")
+	iter, err := p.Split(4)
+	if err != nil {
+		panic(err)
+	}
+	for iter.Next() {
+		fmt.Println(iter.Value().String())
+	}
+	// Output:
+	// 02:00:5e:00:00:00/26
+	// 02:00:5e:40:00:00/26
+	// 02:00:5e:80:00:00/26
+	// 02:00:5e:c0:00:00/26
+}
@@ -0,0 +1,86 @@
+package macaddr
+
+import (
+	"math/bits"
+	"sort"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// addrRange is an inclusive range of addresses as integers.
+type addrRange struct {
+	first, last uint64
+}
+
+// mergeRanges sorts ranges and merges overlapping and adjacent ranges. The input slice is
+// reordered.
+func mergeRanges(ranges []addrRange) []addrRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sort.Slice(ranges, func(i, j int) bool { return ranges[i].first < ranges[j].first })
+	merged := []addrRange{ranges[0]}
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		if last.last == addrBits || r.first <= last.last+1 {
+			if r.last > last.last {
+				last.last = r.last
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// rangePrefixes decomposes an inclusive range into the minimal list of MACPrefixes covering
+// exactly the range, in ascending order.
+func rangePrefixes(first, last uint64) []*MACPrefix {
+	var prefixes []*MACPrefix
+	for first <= last {
+		// The largest block starting at first is limited by the alignment of first, and must not
+		// extend past last.
+		k := constant.MacBitLen
+		if first != 0 {
+			k = bits.TrailingZeros64(first)
+		}
+		for k > 0 && first+(1<<k)-1 > last {
+			k--
+		}
+		l := constant.MacBitLen - k
+		prefixes = append(prefixes, &MACPrefix{MAC: AddrFromUint64(first).MACAddress(), Mask: MaskFromPrefixLen(l)})
+		end := first + (1 << k) - 1
+		if end >= last {
+			break
+		}
+		first = end + 1
+	}
+	return prefixes
+}
+
+// Summarize returns the minimal list of MACPrefixes containing exactly the addresses of the input
+// MACPrefixes and MACAddresses, in ascending order. Duplicate and contained entries are removed,
+// and adjacent entries are merged, e.g. 00:00:5e:00:00:00/25 and 00:00:5e:80:00:00/25 become
+// 00:00:5e:00:00:00/24. Nil and invalid entries are ignored.
+func Summarize(prefixes []*MACPrefix, addrs []*MACAddress) []*MACPrefix {
+	ranges := make([]addrRange, 0, len(prefixes)+len(addrs))
+	for _, p := range prefixes {
+		if p == nil || p.MAC == nil || p.Mask == nil || p.PrefixLen() < 0 {
+			continue
+		}
+		base, mask := p.span()
+		ranges = append(ranges, addrRange{first: base, last: base | addrBits&^mask})
+	}
+	for _, m := range addrs {
+		if m == nil || len(*m) != constant.MacByteLen {
+			continue
+		}
+		v := m.Addr().Uint64()
+		ranges = append(ranges, addrRange{first: v, last: v})
+	}
+	var out []*MACPrefix
+	for _, r := range mergeRanges(ranges) {
+		out = append(out, rangePrefixes(r.first, r.last)...)
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func prefixStrings(prefixes []*macaddr.MACPrefix) []string {
+	out := make([]string, 0, len(prefixes))
+	for _, p := range prefixes {
+		out = append(out, p.String())
+	}
+	return out
+}
+
+func Test_Summarize(t *testing.T) {
+	parse := func(ss ...string) []*macaddr.MACPrefix {
+		var out []*macaddr.MACPrefix
+		for _, s := range ss {
+			_, p := macaddr.MustParseMACPrefix(s)
+			out = append(out, p)
+		}
+		return out
+	}
+	macs := func(ss ...string) []*macaddr.MACAddress {
+		var out []*macaddr.MACAddress
+		for _, s := range ss {
+			out = append(out, macaddr.MustParseMACAddress(s))
+		}
+		return out
+	}
+	t.Run("siblings", func(t *testing.T) {
+		t.Parallel()
+		out := macaddr.Summarize(parse("00:00:5e:80:00:00/25", "00:00:5e:00:00:00/25"), nil)
+		assert.Equal(t, []string{"00:00:5e:00:00:00/24"}, prefixStrings(out))
+	})
+	t.Run("covered and duplicates", func(t *testing.T) {
+		t.Parallel()
+		out := macaddr.Summarize(
+			parse("00:00:5e:00:00:00/24", "00:00:5e:00:53:00/40", "00:00:5e:00:00:00/24", "00:00:5f:00:00:00/24"),
+			macs("00:00:5e:00:53:ab"),
+		)
+		assert.Equal(t, []string{"00:00:5e:00:00:00/23"}, prefixStrings(out))
+	})
+	t.Run("addresses", func(t *testing.T) {
+		t.Parallel()
+		out := macaddr.Summarize(nil, macs(
+			"00:00:5e:00:53:01",
+			"00:00:5e:00:53:02",
+			"00:00:5e:00:53:03",
+			"00:00:5e:00:53:00",
+			"00:00:5e:00:53:05",
+		))
+		assert.Equal(t, []string{"00:00:5e:00:53:00/46", "00:00:5e:00:53:05/48"}, prefixStrings(out))
+	})
+	t.Run("unaligned", func(t *testing.T) {
+		t.Parallel()
+		out := macaddr.Summarize(parse("00:00:5e:00:00:40/42", "00:00:5e:00:00:80/41"), nil)
+		assert.Equal(t, []string{"00:00:5e:00:00:40/42", "00:00:5e:00:00:80/41"}, prefixStrings(out))
+	})
+	t.Run("host bits", func(t *testing.T) {
+		t.Parallel()
+		_, oui := macaddr.MustParseMACPrefix("00:00:5e:00:00:00/24")
+		h := &macaddr.MACPrefix{MAC: macaddr.MustParseMACAddress("00:00:5e:12:34:56"), Mask: oui.Mask}
+		out := macaddr.Summarize([]*macaddr.MACPrefix{h}, nil)
+		assert.Equal(t, []string{"00:00:5e:00:00:00/24"}, prefixStrings(out))
+	})
+	t.Run("whole space", func(t *testing.T) {
+		t.Parallel()
+		out := macaddr.Summarize(parse("00:00:00:00:00:00/1", "80:00:00:00:00:00/1", "ff:ff:ff:ff:ff:ff/48"), nil)
+		assert.Equal(t, []string{"00:00:00:00:00:00/0"}, prefixStrings(out))
+	})
+	t.Run("empty and nil", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, macaddr.Summarize(nil, nil))
+		assert.Empty(t, macaddr.Summarize([]*macaddr.MACPrefix{nil, {}}, []*macaddr.MACAddress{nil}))
+	})
+}
+
+func ExampleSummarize() {
+	_, a := macaddr.MustParseMACPrefix("00:00:5e:00:53:00/41")
+	_, b := macaddr.MustParseMACPrefix("00:00:5e:00:53:80/41")
+	c := macaddr.MustParseMACAddress("00:00:5e:00:53:10")
+	for _, p := range macaddr.Summarize([]*macaddr.MACPrefix{a, b}, []*macaddr.MACAddress{c}) {
+		fmt.Println(p.String())
+	}
+	// Output:
+	// 00:00:5e:00:53:00/40
+}
@@ -0,0 +1,33 @@
+package macaddr
+
+import (
+	"go.mdl.wtf/go-macaddr/internal/constant"
+	"go.mdl.wtf/go-macaddr/internal/vendordb"
+)
+
+// Vendor returns the name of the organization a MACAddress is assigned to, according to the IEEE
+// registry snapshot embedded in this package. The most specific assignment is preferred, e.g. an
+// MA-S or MA-M block over the MA-L block covering it. An empty string is returned if no assignment
+// contains the MACAddress. For a complete, up-to-date registry, see the registry package.
+func (m *MACAddress) Vendor() string {
+	if m == nil || len(*m) != constant.MacByteLen {
+		return ""
+	}
+	v, _ := vendordb.Default().Lookup(uint64(m.Int()), constant.MacBitLen)
+	return v
+}
+
+// Vendor returns the name of the organization a MACPrefix is assigned to, according to the IEEE
+// registry snapshot embedded in this package. Only assignments containing the entire MACPrefix
+// are considered. An empty string is returned if no assignment contains the MACPrefix.
+func (p *MACPrefix) Vendor() string {
+	if p == nil || p.MAC == nil || p.Mask == nil {
+		return ""
+	}
+	l := p.PrefixLen()
+	if l < 0 {
+		return ""
+	}
+	v, _ := vendordb.Default().Lookup(uint64(p.MAC.Int()), l)
+	return v
+}
@@ -0,0 +1,83 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+	"go.mdl.wtf/go-macaddr/registry"
+)
+
+func Test_MACAddress_Vendor(t *testing.T) {
+	t.Run("known", func(t *testing.T) {
+		t.Parallel()
+		m := macaddr.MustParseMACAddress("00:50:56:01:02:03")
+		assert.Equal(t, "VMware, Inc.", m.Vendor())
+		m = macaddr.MustParseMACAddress("3c:22:fb:01:02:03")
+		assert.Equal(t, "Apple, Inc.", m.Vendor())
+	})
+	t.Run("unknown", func(t *testing.T) {
+		t.Parallel()
+		m := macaddr.MustParseMACAddress("02:00:00:01:02:03")
+		assert.Empty(t, m.Vendor())
+	})
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		var m *macaddr.MACAddress
+		assert.Empty(t, m.Vendor())
+		assert.Empty(t, (&macaddr.MACAddress{0x00}).Vendor())
+	})
+}
+
+func Test_MACAddress_Vendor_Snapshot(t *testing.T) {
+	// The embedded snapshot must agree with the IEEE exports it is generated from, including
+	// preferring MA-M, MA-S and IAB assignments over the MA-L blocks covering them.
+	files, err := filepath.Glob(filepath.Join("internal", "vendordb", "ieee", "*.csv"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	reg := registry.New()
+	for _, f := range files {
+		require.NoError(t, reg.LoadFile(f))
+	}
+	for _, e := range reg.Entries() {
+		m := e.Prefix.First()
+		want := reg.Lookup(m)
+		require.NotNil(t, want, m.String())
+		if !assert.Equal(t, want.Organization, m.Vendor(), m.String()) {
+			return
+		}
+	}
+}
+
+func Test_MACPrefix_Vendor(t *testing.T) {
+	t.Run("contained", func(t *testing.T) {
+		t.Parallel()
+		_, p := macaddr.MustParseMACPrefix("00:50:56:00:00:00/26")
+		assert.Equal(t, "VMware, Inc.", p.Vendor())
+	})
+	t.Run("not contained", func(t *testing.T) {
+		t.Parallel()
+		_, p := macaddr.MustParseMACPrefix("00:50:56:00:00:00/16")
+		assert.Empty(t, p.Vendor())
+	})
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		var p *macaddr.MACPrefix
+		assert.Empty(t, p.Vendor())
+		p = &macaddr.MACPrefix{
+			MAC:  macaddr.MustParseMACAddress("00:50:56:00:00:00"),
+			Mask: &macaddr.MACAddress{0xff, 0xff, 0xff, 0xff, 0, 0x5},
+		}
+		assert.Empty(t, p.Vendor())
+	})
+}
+
+func ExampleMACAddress_Vendor() {
+	mac := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+	fmt.Println(mac.Vendor())
+	// Output:
+	// ICANN, IANA Department
+}
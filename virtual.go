@@ -0,0 +1,149 @@
+package macaddr
+
+import (
+	"fmt"
+	"io"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// Platform is a virtualization or container platform that assigns MAC addresses to virtual
+// network interfaces.
+type Platform int
+
+const (
+	// PlatformUnknown is an unknown platform, e.g. a physical network interface.
+	PlatformUnknown Platform = iota
+	// PlatformQEMU is QEMU/KVM, including libvirt, using 52:54:00.
+	PlatformQEMU
+	// PlatformXen is the Xen Project hypervisor, using 00:16:3e.
+	PlatformXen
+	// PlatformVMware is VMware ESXi, Workstation and Fusion, using 00:50:56, 00:0c:29 and 00:05:69.
+	PlatformVMware
+	// PlatformHyperV is Microsoft Hyper-V, using 00:15:5d.
+	PlatformHyperV
+	// PlatformVirtualBox is Oracle VirtualBox, using 08:00:27.
+	PlatformVirtualBox
+	// PlatformParallels is Parallels Desktop, using 00:1c:42.
+	PlatformParallels
+	// PlatformDocker is the Docker default bridge network, using 02:42.
+	PlatformDocker
+)
+
+// String returns the name of the Platform, e.g. 'VMware'.
+func (p Platform) String() string {
+	switch p {
+	case PlatformQEMU:
+		return "QEMU"
+	case PlatformXen:
+		return "Xen"
+	case PlatformVMware:
+		return "VMware"
+	case PlatformHyperV:
+		return "Hyper-V"
+	case PlatformVirtualBox:
+		return "VirtualBox"
+	case PlatformParallels:
+		return "Parallels"
+	case PlatformDocker:
+		return "Docker"
+	}
+	return "Unknown"
+}
+
+// PlatformPrefix is a range of MAC addresses used by a virtualization or container platform.
+type PlatformPrefix struct {
+	// Platform is the platform that assigns addresses from the range.
+	Platform Platform
+	// Prefix is the range of addresses.
+	Prefix *MACPrefix
+	// Description is a human-readable description of the range, e.g. 'VMware manually assigned'.
+	Description string
+}
+
+// String returns a human-readable representation of the PlatformPrefix.
+func (p *PlatformPrefix) String() string {
+	if p == nil {
+		return constant.NilStr
+	}
+	return fmt.Sprintf("%s %s", p.Prefix.String(), p.Description)
+}
+
+// platformPrefixes is the table of platform prefixes.
+var platformPrefixes = newPrefixTable([]*PlatformPrefix{
+	{PlatformQEMU, mustPrefix("52:54:00:00:00:00/24"), "QEMU/KVM"},
+	{PlatformXen, mustPrefix("00:16:3e:00:00:00/24"), "Xen"},
+	{PlatformVMware, mustPrefix("00:50:56:00:00:00/24"), "VMware"},
+	{PlatformVMware, mustPrefix("00:50:56:00:00:00/26"), "VMware manually assigned"},
+	{PlatformVMware, mustPrefix("00:0c:29:00:00:00/24"), "VMware host generated"},
+	{PlatformVMware, mustPrefix("00:05:69:00:00:00/24"), "VMware ESX"},
+	{PlatformHyperV, mustPrefix("00:15:5d:00:00:00/24"), "Microsoft Hyper-V"},
+	{PlatformVirtualBox, mustPrefix("08:00:27:00:00:00/24"), "Oracle VirtualBox"},
+	{PlatformParallels, mustPrefix("00:1c:42:00:00:00/24"), "Parallels"},
+	{PlatformDocker, mustPrefix("02:42:00:00:00:00/16"), "Docker bridge"},
+}, func(p *PlatformPrefix) *MACPrefix { return p.Prefix })
+
+// platformGeneration maps each Platform to the prefix new addresses are generated within, which
+// follows the platform's allocation rules. For example, addresses for VMware are generated within
+// the range reserved for manually assigned addresses, so they never conflict with addresses
+// generated by vCenter or ESXi.
+var platformGeneration = map[Platform]string{
+	PlatformQEMU:       "52:54:00:00:00:00/24",
+	PlatformXen:        "00:16:3e:00:00:00/24",
+	PlatformVMware:     "00:50:56:00:00:00/26",
+	PlatformHyperV:     "00:15:5d:00:00:00/24",
+	PlatformVirtualBox: "08:00:27:00:00:00/24",
+	PlatformParallels:  "00:1c:42:00:00:00/24",
+	PlatformDocker:     "02:42:00:00:00:00/16",
+}
+
+// PlatformPrefixes returns every virtualization and container platform prefix, sorted by prefix
+// length, longest first. The entries are shared with LookupPlatform, so must not be modified.
+func PlatformPrefixes() []*PlatformPrefix {
+	return platformPrefixes.all()
+}
+
+// LookupPlatform returns the most specific platform prefix containing a MACAddress, or nil if the
+// MACAddress is not assigned by a known virtualization or container platform.
+func LookupPlatform(mac *MACAddress) *PlatformPrefix {
+	p, _ := platformPrefixes.lookup(mac)
+	return p
+}
+
+// Platform returns the virtualization or container platform that assigned the MACAddress, or
+// PlatformUnknown if the MACAddress is not within a known platform prefix.
+func (m *MACAddress) Platform() Platform {
+	if p := LookupPlatform(m); p != nil {
+		return p.Platform
+	}
+	return PlatformUnknown
+}
+
+// IsVirtual determines if the MACAddress was assigned by a known virtualization or container
+// platform.
+func (m *MACAddress) IsVirtual() bool {
+	return m.Platform() != PlatformUnknown
+}
+
+// GenerationPrefix returns the prefix new addresses for the Platform are generated within, or
+// nil if the Platform is unknown.
+func (p Platform) GenerationPrefix() *MACPrefix {
+	s, ok := platformGeneration[p]
+	if !ok {
+		return nil
+	}
+	_, prefix := MustParseMACPrefix(s)
+	return prefix
+}
+
+// GeneratePlatformMAC generates a random MACAddress that conforms to a Platform's allocation
+// rules, as described by Platform.GenerationPrefix. Random bytes are read from r, or from
+// crypto/rand if r is nil.
+func GeneratePlatformMAC(p Platform, r io.Reader) (*MACAddress, error) {
+	prefix := p.GenerationPrefix()
+	if prefix == nil {
+		return nil, fmt.Errorf("'%s' is not a platform addresses can be generated for", p)
+	}
+	g := Generator{Rand: r, Prefix: prefix, Universal: prefix.IsUniversal()}
+	return g.Generate()
+}
@@ -0,0 +1,120 @@
+package macaddr_test
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_MACAddress_Platform(t *testing.T) {
+	type pair struct {
+		mac      string
+		platform macaddr.Platform
+		desc     string
+	}
+	tests := []pair{
+		{"52:54:00:12:34:56", macaddr.PlatformQEMU, "QEMU/KVM"},
+		{"00:16:3e:12:34:56", macaddr.PlatformXen, "Xen"},
+		{"00:50:56:12:34:56", macaddr.PlatformVMware, "VMware manually assigned"},
+		{"00:50:56:92:34:56", macaddr.PlatformVMware, "VMware"},
+		{"00:0c:29:12:34:56", macaddr.PlatformVMware, "VMware host generated"},
+		{"00:15:5d:12:34:56", macaddr.PlatformHyperV, "Microsoft Hyper-V"},
+		{"08:00:27:12:34:56", macaddr.PlatformVirtualBox, "Oracle VirtualBox"},
+		{"00:1c:42:12:34:56", macaddr.PlatformParallels, "Parallels"},
+		{"02:42:ac:11:00:02", macaddr.PlatformDocker, "Docker bridge"},
+	}
+	for _, p := range tests {
+		p := p
+		t.Run(p.mac, func(t *testing.T) {
+			t.Parallel()
+			mac := macaddr.MustParseMACAddress(p.mac)
+			assert.Equal(t, p.platform, mac.Platform())
+			assert.True(t, mac.IsVirtual())
+			pp := macaddr.LookupPlatform(mac)
+			require.NotNil(t, pp)
+			assert.Equal(t, p.desc, pp.Description)
+		})
+	}
+	t.Run("physical", func(t *testing.T) {
+		t.Parallel()
+		mac := macaddr.MustParseMACAddress("00:00:5e:00:53:ab")
+		assert.Equal(t, macaddr.PlatformUnknown, mac.Platform())
+		assert.False(t, mac.IsVirtual())
+		assert.Nil(t, macaddr.LookupPlatform(mac))
+		assert.Nil(t, macaddr.LookupPlatform(nil))
+	})
+}
+
+func Test_PlatformPrefixes(t *testing.T) {
+	all := macaddr.PlatformPrefixes()
+	require.NotEmpty(t, all)
+	for i := 1; i < len(all); i++ {
+		assert.GreaterOrEqual(t, all[i-1].Prefix.PrefixLen(), all[i].Prefix.PrefixLen())
+	}
+	assert.Equal(t, "00:50:56:00:00:00/26 VMware manually assigned", all[0].String())
+	var p *macaddr.PlatformPrefix
+	assert.Equal(t, "<nil>", p.String())
+}
+
+func Test_GeneratePlatformMAC(t *testing.T) {
+	platforms := []macaddr.Platform{
+		macaddr.PlatformQEMU,
+		macaddr.PlatformXen,
+		macaddr.PlatformVMware,
+		macaddr.PlatformHyperV,
+		macaddr.PlatformVirtualBox,
+		macaddr.PlatformParallels,
+		macaddr.PlatformDocker,
+	}
+	for _, p := range platforms {
+		p := p
+		t.Run(p.String(), func(t *testing.T) {
+			t.Parallel()
+			r := rand.New(rand.NewSource(1))
+			prefix := p.GenerationPrefix()
+			for i := 0; i < 100; i++ {
+				mac, err := macaddr.GeneratePlatformMAC(p, r)
+				require.NoError(t, err)
+				assert.True(t, prefix.Contains(mac), mac.String())
+				assert.Equal(t, p, mac.Platform())
+			}
+		})
+	}
+	t.Run("VMware manual range", func(t *testing.T) {
+		t.Parallel()
+		mac, err := macaddr.GeneratePlatformMAC(macaddr.PlatformVMware, bytes.NewReader(bytes.Repeat([]byte{0xff}, 6)))
+		require.NoError(t, err)
+		assert.Equal(t, "00:50:56:3f:ff:ff", mac.String())
+	})
+	t.Run("crypto/rand", func(t *testing.T) {
+		t.Parallel()
+		mac, err := macaddr.GeneratePlatformMAC(macaddr.PlatformQEMU, nil)
+		require.NoError(t, err)
+		assert.Equal(t, macaddr.PlatformQEMU, mac.Platform())
+	})
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		_, err := macaddr.GeneratePlatformMAC(macaddr.PlatformUnknown, nil)
+		assert.Error(t, err)
+		_, err = macaddr.GeneratePlatformMAC(macaddr.PlatformQEMU, bytes.NewReader([]byte{1, 2}))
+		assert.Error(t, err)
+		assert.Nil(t, macaddr.PlatformUnknown.GenerationPrefix())
+	})
+}
+
+func ExampleGeneratePlatformMAC() {
+	mac, err := macaddr.GeneratePlatformMAC(macaddr.PlatformVMware, bytes.NewReader([]byte{0, 0, 0, 0x12, 0x34, 0x56}))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(mac.String())
+	fmt.Println(mac.Platform())
+	// Output:
+	// 00:50:56:12:34:56
+	// VMware
+}
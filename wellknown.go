@@ -0,0 +1,126 @@
+package macaddr
+
+import (
+	"fmt"
+
+	"go.mdl.wtf/go-macaddr/internal/constant"
+)
+
+// WellKnownClass classifies the protocol or purpose of a well-known address or range.
+type WellKnownClass int
+
+const (
+	// ClassUnknown is an address that is not well-known.
+	ClassUnknown WellKnownClass = iota
+	// ClassBroadcast is the broadcast address, ff:ff:ff:ff:ff:ff.
+	ClassBroadcast
+	// ClassBridgeFiltered is the IEEE 802.1Q reserved group addresses, 01:80:c2:00:00:00/44,
+	// which are not forwarded by bridges.
+	ClassBridgeFiltered
+	// ClassSTP is the Spanning Tree Protocol bridge group address.
+	ClassSTP
+	// ClassPause is the IEEE 802.3 MAC Control PAUSE address.
+	ClassPause
+	// ClassSlowProtocols is the IEEE 802.3 Slow Protocols address, used by LACP, Marker and OAM.
+	ClassSlowProtocols
+	// ClassPAE is the IEEE 802.1X Port Access Entity address.
+	ClassPAE
+	// ClassLLDP is the Link Layer Discovery Protocol nearest bridge address.
+	ClassLLDP
+	// ClassCisco is a Cisco control protocol address, e.g. CDP, VTP or PVST+.
+	ClassCisco
+	// ClassIPv4Multicast is the IPv4 multicast range, 01:00:5e:00:00:00/25.
+	ClassIPv4Multicast
+	// ClassIPv6Multicast is the IPv6 multicast range, 33:33:00:00:00:00/16.
+	ClassIPv6Multicast
+	// ClassDocumentation is a range reserved for documentation by RFC 7042.
+	ClassDocumentation
+)
+
+// String returns a short name for the WellKnownClass, e.g. 'LLDP'.
+func (c WellKnownClass) String() string {
+	switch c {
+	case ClassBroadcast:
+		return "Broadcast"
+	case ClassBridgeFiltered:
+		return "Bridge Filtered"
+	case ClassSTP:
+		return "STP"
+	case ClassPause:
+		return "PAUSE"
+	case ClassSlowProtocols:
+		return "Slow Protocols"
+	case ClassPAE:
+		return "802.1X"
+	case ClassLLDP:
+		return "LLDP"
+	case ClassCisco:
+		return "Cisco"
+	case ClassIPv4Multicast:
+		return "IPv4 Multicast"
+	case ClassIPv6Multicast:
+		return "IPv6 Multicast"
+	case ClassDocumentation:
+		return "Documentation"
+	}
+	return "Unknown"
+}
+
+// WellKnown is a well-known address or range and its meaning.
+type WellKnown struct {
+	// Prefix is the range of the well-known address. A single address has a prefix length of 48.
+	Prefix *MACPrefix
+	// Class is the protocol or purpose of the address.
+	Class WellKnownClass
+	// Description is a human-readable description of the address, e.g. 'Slow Protocols (LACP,
+	// Marker, OAM)'.
+	Description string
+}
+
+// String returns a human-readable representation of the WellKnown address.
+func (w *WellKnown) String() string {
+	if w == nil {
+		return constant.NilStr
+	}
+	return fmt.Sprintf("%s %s", w.Prefix.String(), w.Description)
+}
+
+// wellKnown is the table of well-known addresses.
+var wellKnown = newPrefixTable([]*WellKnown{
+	{mustPrefix("ff:ff:ff:ff:ff:ff/48"), ClassBroadcast, "Broadcast"},
+	{mustPrefix("01:80:c2:00:00:00/44"), ClassBridgeFiltered, "IEEE 802.1Q reserved group addresses"},
+	{mustPrefix("01:80:c2:00:00:00/48"), ClassSTP, "Spanning Tree Protocol (Nearest Customer Bridge)"},
+	{mustPrefix("01:80:c2:00:00:01/48"), ClassPause, "IEEE 802.3 MAC Control PAUSE"},
+	{mustPrefix("01:80:c2:00:00:02/48"), ClassSlowProtocols, "Slow Protocols (LACP, Marker, OAM)"},
+	{mustPrefix("01:80:c2:00:00:03/48"), ClassPAE, "IEEE 802.1X Port Access Entity (Nearest non-TPMR Bridge)"},
+	{mustPrefix("01:80:c2:00:00:0e/48"), ClassLLDP, "Link Layer Discovery Protocol (Nearest Bridge)"},
+	{mustPrefix("01:00:0c:cc:cc:cc/48"), ClassCisco, "Cisco CDP, VTP, DTP, PAgP and UDLD"},
+	{mustPrefix("01:00:0c:cc:cc:cd/48"), ClassCisco, "Cisco PVST+"},
+	{mustPrefix("01:00:5e:00:00:00/25"), ClassIPv4Multicast, "IPv4 multicast"},
+	{mustPrefix("33:33:00:00:00:00/16"), ClassIPv6Multicast, "IPv6 multicast"},
+	{mustPrefix("00:00:5e:00:53:00/40"), ClassDocumentation, "RFC 7042 unicast documentation"},
+	{mustPrefix("01:00:5e:90:10:00/40"), ClassDocumentation, "RFC 7042 multicast documentation"},
+}, func(w *WellKnown) *MACPrefix { return w.Prefix })
+
+// WellKnownAddresses returns every well-known address and range, sorted by prefix length,
+// longest first. The slice is a copy, but the entries are shared and must not be modified.
+func WellKnownAddresses() []*WellKnown {
+	return wellKnown.all()
+}
+
+// LookupWellKnown returns the most specific well-known address or range containing a MACAddress,
+// e.g. LLDP is preferred over the IEEE 802.1Q reserved range containing it. LookupWellKnown
+// returns nil if the MACAddress is not well-known.
+func LookupWellKnown(mac *MACAddress) *WellKnown {
+	w, _ := wellKnown.lookup(mac)
+	return w
+}
+
+// WellKnownClass returns the WellKnownClass of the most specific well-known address or range
+// containing the MACAddress, or ClassUnknown if the MACAddress is not well-known.
+func (m *MACAddress) WellKnownClass() WellKnownClass {
+	if w := LookupWellKnown(m); w != nil {
+		return w.Class
+	}
+	return ClassUnknown
+}
@@ -0,0 +1,82 @@
+package macaddr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mdl.wtf/go-macaddr"
+)
+
+func Test_LookupWellKnown(t *testing.T) {
+	type pair struct {
+		mac   string
+		class macaddr.WellKnownClass
+	}
+	tests := []pair{
+		{"ff:ff:ff:ff:ff:ff", macaddr.ClassBroadcast},
+		{"01:80:c2:00:00:00", macaddr.ClassSTP},
+		{"01:80:c2:00:00:01", macaddr.ClassPause},
+		{"01:80:c2:00:00:02", macaddr.ClassSlowProtocols},
+		{"01:80:c2:00:00:03", macaddr.ClassPAE},
+		{"01:80:c2:00:00:0e", macaddr.ClassLLDP},
+		{"01:80:c2:00:00:0f", macaddr.ClassBridgeFiltered},
+		{"01:80:c2:00:00:10", macaddr.ClassUnknown},
+		{"01:00:0c:cc:cc:cc", macaddr.ClassCisco},
+		{"01:00:0c:cc:cc:cd", macaddr.ClassCisco},
+		{"01:00:5e:00:00:fb", macaddr.ClassIPv4Multicast},
+		{"01:00:5e:7f:ff:ff", macaddr.ClassIPv4Multicast},
+		{"01:00:5e:80:00:00", macaddr.ClassUnknown},
+		{"33:33:00:00:00:01", macaddr.ClassIPv6Multicast},
+		{"00:00:5e:00:53:ab", macaddr.ClassDocumentation},
+		{"01:00:5e:90:10:ab", macaddr.ClassDocumentation},
+		{"00:00:5e:00:54:ab", macaddr.ClassUnknown},
+	}
+	for _, p := range tests {
+		p := p
+		t.Run(p.mac, func(t *testing.T) {
+			t.Parallel()
+			mac := macaddr.MustParseMACAddress(p.mac)
+			assert.Equal(t, p.class, mac.WellKnownClass())
+			w := macaddr.LookupWellKnown(mac)
+			if p.class == macaddr.ClassUnknown {
+				assert.Nil(t, w)
+				return
+			}
+			require.NotNil(t, w)
+			assert.True(t, w.Prefix.Contains(mac))
+		})
+	}
+	t.Run("nil", func(t *testing.T) {
+		t.Parallel()
+		assert.Nil(t, macaddr.LookupWellKnown(nil))
+		var w *macaddr.WellKnown
+		assert.Equal(t, "<nil>", w.String())
+	})
+}
+
+func Test_WellKnownAddresses(t *testing.T) {
+	all := macaddr.WellKnownAddresses()
+	require.NotEmpty(t, all)
+	for i := 1; i < len(all); i++ {
+		assert.GreaterOrEqual(t, all[i-1].Prefix.PrefixLen(), all[i].Prefix.PrefixLen())
+	}
+	all[0] = nil
+	assert.NotNil(t, macaddr.WellKnownAddresses()[0])
+}
+
+func Test_WellKnownClass_String(t *testing.T) {
+	assert.Equal(t, "LLDP", macaddr.ClassLLDP.String())
+	assert.Equal(t, "IPv6 Multicast", macaddr.ClassIPv6Multicast.String())
+	assert.Equal(t, "Unknown", macaddr.WellKnownClass(99).String())
+}
+
+func ExampleLookupWellKnown() {
+	w := macaddr.LookupWellKnown(macaddr.MustParseMACAddress("01:80:c2:00:00:0e"))
+	fmt.Println(w.Class)
+	fmt.Println(w.String())
+	// Output:
+	// LLDP
+	// 01:80:c2:00:00:0e/48 Link Layer Discovery Protocol (Nearest Bridge)
+}